@@ -0,0 +1,236 @@
+package mollie
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+)
+
+// ErrCurrencyMismatch is returned when trying to combine or compare
+// amounts expressed in different currencies.
+var ErrCurrencyMismatch = errors.New("amounts with different currencies can not be combined")
+
+// ErrInvalidAmount is returned when a value can not be formatted as
+// an amount of the given currency.
+var ErrInvalidAmount = errors.New("invalid amount")
+
+// currencyDecimals lists the currencies whose minor units differ
+// from the default of two decimals.
+var currencyDecimals = map[string]int{
+	"CLP": 0,
+	"ISK": 0,
+	"JPY": 0,
+	"KRW": 0,
+	"VND": 0,
+	"XAF": 0,
+	"XOF": 0,
+	"BHD": 3,
+	"IQD": 3,
+	"JOD": 3,
+	"KWD": 3,
+	"LYD": 3,
+	"OMR": 3,
+	"TND": 3,
+}
+
+var (
+	currencyExpr = regexp.MustCompile(`^[A-Z]{3}$`)
+	valueExpr    = regexp.MustCompile(`^-?\d+(\.\d+)?$`)
+)
+
+// decimalsFor returns the number of decimals used by the given currency.
+func decimalsFor(currency string) int {
+	if d, ok := currencyDecimals[strings.ToUpper(currency)]; ok {
+		return d
+	}
+
+	return 2
+}
+
+// FormatAmount formats the value with the number of decimals Mollie expects
+// for the currency, e.g. "10.00" for 10 EUR, "1000" for 1000 JPY and "1.500"
+// for 1.5 KWD. Values with more decimals than the currency has are rejected
+// instead of rounded, so no amount is silently changed.
+func FormatAmount(currency string, value *big.Rat) (string, error) {
+	if !currencyExpr.MatchString(currency) {
+		return "", fmt.Errorf("%w: currency %q is not an ISO 4217 code", ErrInvalidAmount, currency)
+	}
+
+	if value == nil {
+		return "", fmt.Errorf("%w: value is nil", ErrInvalidAmount)
+	}
+
+	d := decimalsFor(currency)
+	s := value.FloatString(d)
+
+	if r, _ := new(big.Rat).SetString(s); r.Cmp(value) != 0 {
+		return "", fmt.Errorf("%w: %s uses %d decimals", ErrInvalidAmount, currency, d)
+	}
+
+	return s, nil
+}
+
+// validateAmount checks the value of a is formatted as Mollie expects for its
+// currency, missing amounts are left for Mollie to reject.
+func validateAmount(field string, a *Amount) error {
+	if a == nil {
+		return nil
+	}
+
+	r, err := a.Rat()
+	if err != nil {
+		return &ValidationError{Field: field, Reason: err.Error()}
+	}
+
+	s, err := FormatAmount(a.Currency, r)
+	if err != nil {
+		return &ValidationError{Field: field, Reason: err.Error()}
+	}
+
+	if s != a.Value {
+		return &ValidationError{Field: field, Reason: fmt.Sprintf("must be formatted as %s for %s", s, a.Currency)}
+	}
+
+	return nil
+}
+
+// NewAmount builds an Amount for the given currency, the value is rounded
+// to the number of decimals used by the currency, e.g. 10 EUR becomes "10.00".
+func NewAmount(currency string, value *big.Rat) *Amount {
+	if value == nil {
+		value = new(big.Rat)
+	}
+
+	return &Amount{
+		Currency: currency,
+		Value:    value.FloatString(decimalsFor(currency)),
+	}
+}
+
+// Rat returns the exact decimal value of the amount.
+//
+// Only plain decimal values are accepted, e.g. "10.00" or "-0.25", fractions,
+// exponents and surrounding spaces are rejected.
+func (a *Amount) Rat() (*big.Rat, error) {
+	if a == nil {
+		return nil, errors.New("amount is nil")
+	}
+
+	if !valueExpr.MatchString(a.Value) {
+		return nil, fmt.Errorf("invalid amount value %q", a.Value)
+	}
+
+	r, ok := new(big.Rat).SetString(a.Value)
+	if !ok {
+		return nil, fmt.Errorf("invalid amount value %q", a.Value)
+	}
+
+	return r, nil
+}
+
+// String returns the amount followed by its currency, e.g. "10.00 EUR".
+func (a Amount) String() string {
+	return strings.TrimSpace(a.Value + " " + a.Currency)
+}
+
+// Decimal returns the exact decimal value of the amount, it is the
+// preferred way to operate with amounts.
+func (a *Amount) Decimal() (*big.Rat, error) {
+	return a.Rat()
+}
+
+// Float64 returns the value of the amount as a float64.
+//
+// Warning: floats can not represent most decimal values exactly, e.g. 0.10 is
+// stored as 0.1000000000000000055..., using them to add or compare amounts
+// leads to rounding errors. Use Decimal, Add, Subtract or Cmp instead and
+// only rely on Float64 for display or statistics purposes.
+func (a *Amount) Float64() (float64, error) {
+	r, err := a.Rat()
+	if err != nil {
+		return 0, err
+	}
+
+	f, _ := r.Float64()
+
+	return f, nil
+}
+
+// SameCurrency reports whether both amounts are present and expressed
+// in the same currency.
+func (a *Amount) SameCurrency(other *Amount) bool {
+	if a == nil || other == nil {
+		return false
+	}
+
+	return strings.EqualFold(a.Currency, other.Currency)
+}
+
+// Add returns a new amount holding the sum of both amounts.
+func (a *Amount) Add(other *Amount) (*Amount, error) {
+	x, y, err := a.operands(other)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewAmount(a.Currency, x.Add(x, y)), nil
+}
+
+// Subtract returns a new amount holding the difference between both amounts.
+func (a *Amount) Subtract(other *Amount) (*Amount, error) {
+	x, y, err := a.operands(other)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewAmount(a.Currency, x.Sub(x, y)), nil
+}
+
+// Cmp compares both amounts and returns -1, 0 or +1 when the amount
+// is lower, equal or greater than other.
+func (a *Amount) Cmp(other *Amount) (int, error) {
+	x, y, err := a.operands(other)
+	if err != nil {
+		return 0, err
+	}
+
+	return x.Cmp(y), nil
+}
+
+// GreaterThan reports whether the amount is greater than other.
+func (a *Amount) GreaterThan(other *Amount) (bool, error) {
+	c, err := a.Cmp(other)
+
+	return c > 0, err
+}
+
+// Equal reports whether both amounts represent the same value,
+// "10.0" and "10.00" are considered equal.
+func (a *Amount) Equal(other *Amount) (bool, error) {
+	c, err := a.Cmp(other)
+
+	return c == 0 && err == nil, err
+}
+
+// operands validates both amounts can be combined and returns their values.
+func (a *Amount) operands(other *Amount) (x, y *big.Rat, err error) {
+	if a == nil || other == nil {
+		return nil, nil, errors.New("amount is nil")
+	}
+
+	if !a.SameCurrency(other) {
+		return nil, nil, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, a.Currency, other.Currency)
+	}
+
+	if x, err = a.Rat(); err != nil {
+		return nil, nil, err
+	}
+
+	if y, err = other.Rat(); err != nil {
+		return nil, nil, err
+	}
+
+	return x, y, nil
+}
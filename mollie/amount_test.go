@@ -0,0 +1,277 @@
+package mollie
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAmount(t *testing.T) {
+	cases := []struct {
+		name     string
+		currency string
+		value    *big.Rat
+		want     string
+	}{
+		{
+			"two decimals currency",
+			"EUR",
+			big.NewRat(10, 1),
+			"10.00",
+		},
+		{
+			"values are rounded to the minor units",
+			"EUR",
+			big.NewRat(10005, 1000),
+			"10.01",
+		},
+		{
+			"zero decimals currency",
+			"JPY",
+			big.NewRat(1500, 1),
+			"1500",
+		},
+		{
+			"three decimals currency",
+			"KWD",
+			big.NewRat(1, 8),
+			"0.125",
+		},
+		{
+			"nil values are zero",
+			"EUR",
+			nil,
+			"0.00",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := NewAmount(c.currency, c.value)
+			assert.Equal(t, c.currency, got.Currency)
+			assert.Equal(t, c.want, got.Value)
+		})
+	}
+}
+
+func TestAmount_Arithmetic(t *testing.T) {
+	a := &Amount{Currency: "EUR", Value: "10.00"}
+	b := &Amount{Currency: "EUR", Value: "2.55"}
+
+	sum, err := a.Add(b)
+	assert.Nil(t, err)
+	assert.Equal(t, &Amount{Currency: "EUR", Value: "12.55"}, sum)
+
+	diff, err := a.Subtract(b)
+	assert.Nil(t, err)
+	assert.Equal(t, &Amount{Currency: "EUR", Value: "7.45"}, diff)
+
+	neg, err := b.Subtract(a)
+	assert.Nil(t, err)
+	assert.Equal(t, &Amount{Currency: "EUR", Value: "-7.45"}, neg)
+
+	gt, err := a.GreaterThan(b)
+	assert.Nil(t, err)
+	assert.True(t, gt)
+
+	eq, err := a.Equal(&Amount{Currency: "EUR", Value: "10.0"})
+	assert.Nil(t, err)
+	assert.True(t, eq)
+}
+
+func TestAmount_ArithmeticErrors(t *testing.T) {
+	eur := &Amount{Currency: "EUR", Value: "10.00"}
+
+	cases := []struct {
+		name  string
+		other *Amount
+		err   string
+	}{
+		{
+			"currencies must match",
+			&Amount{Currency: "USD", Value: "10.00"},
+			"amounts with different currencies can not be combined: EUR and USD",
+		},
+		{
+			"values must be valid decimals",
+			&Amount{Currency: "EUR", Value: "ten"},
+			`invalid amount value "ten"`,
+		},
+		{
+			"amounts can not be nil",
+			nil,
+			"amount is nil",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			_, err := eur.Add(c.other)
+			assert.EqualError(t, err, c.err)
+
+			_, err = eur.Subtract(c.other)
+			assert.EqualError(t, err, c.err)
+
+			eq, err := eur.Equal(c.other)
+			assert.EqualError(t, err, c.err)
+			assert.False(t, eq)
+		})
+	}
+}
+
+func TestAmount_SameCurrency(t *testing.T) {
+	eur := &Amount{Currency: "EUR", Value: "10.00"}
+
+	cases := []struct {
+		name  string
+		other *Amount
+		want  bool
+	}{
+		{"same currencies match", &Amount{Currency: "EUR", Value: "5.00"}, true},
+		{"currencies are compared case insensitive", &Amount{Currency: "eur", Value: "5.00"}, true},
+		{"different currencies do not match", &Amount{Currency: "USD", Value: "10.00"}, false},
+		{"missing amounts do not match", nil, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.want, eur.SameCurrency(c.other))
+		})
+	}
+}
+
+func TestAmount_Conversions(t *testing.T) {
+	cases := []struct {
+		name    string
+		amount  *Amount
+		str     string
+		decimal *big.Rat
+		float   float64
+	}{
+		{
+			"two decimals currency",
+			&Amount{Currency: "EUR", Value: "10.00"},
+			"10.00 EUR",
+			big.NewRat(10, 1),
+			10,
+		},
+		{
+			"three decimals currency",
+			&Amount{Currency: "BHD", Value: "1.125"},
+			"1.125 BHD",
+			big.NewRat(1125, 1000),
+			1.125,
+		},
+		{
+			"zero decimals currency",
+			&Amount{Currency: "JPY", Value: "1500"},
+			"1500 JPY",
+			big.NewRat(1500, 1),
+			1500,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.str, c.amount.String())
+			assert.Equal(t, c.str, fmt.Sprint(c.amount))
+			assert.Equal(t, c.str, fmt.Sprint(*c.amount))
+
+			d, err := c.amount.Decimal()
+			require.NoError(t, err)
+			assert.Equal(t, 0, d.Cmp(c.decimal))
+
+			f, err := c.amount.Float64()
+			require.NoError(t, err)
+			assert.Equal(t, c.float, f)
+
+			b, err := json.Marshal(c.amount)
+			require.NoError(t, err)
+			assert.JSONEq(t, fmt.Sprintf(`{"currency":%q,"value":%q}`, c.amount.Currency, c.amount.Value), string(b))
+
+			var got Amount
+			require.NoError(t, json.Unmarshal(b, &got))
+			assert.Equal(t, *c.amount, got)
+		})
+	}
+}
+
+func TestAmount_ConversionErrors(t *testing.T) {
+	_, err := (&Amount{Currency: "EUR", Value: "ten"}).Float64()
+	assert.EqualError(t, err, `invalid amount value "ten"`)
+
+	for _, v := range []string{"1/3", "1e3", " 10", "10.", ".5", "+1.00", ""} {
+		_, err = (&Amount{Currency: "EUR", Value: v}).Rat()
+		assert.EqualError(t, err, fmt.Sprintf("invalid amount value %q", v))
+	}
+
+	var a *Amount
+
+	_, err = a.Decimal()
+	assert.EqualError(t, err, "amount is nil")
+}
+
+func TestFormatAmount(t *testing.T) {
+	cases := []struct {
+		name     string
+		currency string
+		value    *big.Rat
+		want     string
+		err      string
+	}{
+		{"two decimal currencies are padded", "EUR", big.NewRat(10, 1), "10.00", ""},
+		{"zero decimal currencies have no decimals", "JPY", big.NewRat(1000, 1), "1000", ""},
+		{"three decimal currencies are padded", "KWD", big.NewRat(3, 2), "1.500", ""},
+		{"negative values are formatted", "EUR", big.NewRat(-1, 4), "-0.25", ""},
+		{"extra decimals are rejected", "EUR", big.NewRat(10005, 1000), "", "invalid amount: EUR uses 2 decimals"},
+		{"decimals of zero decimal currencies are rejected", "JPY", big.NewRat(1, 2), "", "invalid amount: JPY uses 0 decimals"},
+		{"unknown currency codes are rejected", "euro", big.NewRat(1, 1), "", `invalid amount: currency "euro" is not an ISO 4217 code`},
+		{"nil values are rejected", "EUR", nil, "", "invalid amount: value is nil"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := FormatAmount(c.currency, c.value)
+			if c.err != "" {
+				assert.ErrorIs(t, err, ErrInvalidAmount)
+				assert.EqualError(t, err, c.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, c.want, got)
+		})
+	}
+}
+
+func TestValidateAmount(t *testing.T) {
+	cases := []struct {
+		name   string
+		amount *Amount
+		err    string
+	}{
+		{"missing amounts are accepted", nil, ""},
+		{"well formatted amounts are accepted", &Amount{Currency: "EUR", Value: "10.00"}, ""},
+		{"missing decimals are rejected", &Amount{Currency: "EUR", Value: "10"}, "validation_error: amount must be formatted as 10.00 for EUR"},
+		{"extra decimals are rejected", &Amount{Currency: "JPY", Value: "1000.00"}, "validation_error: amount must be formatted as 1000 for JPY"},
+		{"invalid values are rejected", &Amount{Currency: "EUR", Value: "ten"}, `validation_error: amount invalid amount value "ten"`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := validateAmount("amount", c.amount)
+			if c.err == "" {
+				assert.Nil(t, err)
+
+				return
+			}
+
+			assert.EqualError(t, err, c.err)
+		})
+	}
+}
@@ -2,7 +2,6 @@ package mollie
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"time"
 )
@@ -52,6 +51,7 @@ type BalanceLinks struct {
 type Balance struct {
 	ID                  string               `json:"id,omitempty"`
 	Resource            string               `json:"resource,omitempty"`
+	Mode                Mode                 `json:"mode,omitempty"`
 	Currency            string               `json:"currency,omitempty"`
 	TransferReference   string               `json:"transferReference,omitempty"`
 	Status              BalanceStatus        `json:"status,omitempty"`
@@ -222,7 +222,7 @@ type ListBalanceTransactionsOptions struct {
 // See: https://docs.mollie.com/reference/v2/balances-api/overview
 type BalancesService service
 
-// GetBalance retrieves a balance by its id.
+// Get retrieves a balance by its id.
 //
 // See: https://docs.mollie.com/reference/v2/balances-api/get-balance
 func (bs *BalancesService) Get(ctx context.Context, balance string) (res *Response, b *Balance, err error) {
@@ -273,11 +273,11 @@ func (bs *BalancesService) GetPrimaryReport(ctx context.Context, options *Balanc
 	return bs.getReport(ctx, "primary", options)
 }
 
-// GetTransactionsList retrieves a list of movements (transactions) for the
+// ListTransactions retrieves a list of movements (transactions) for the
 // specified balance.
 //
 // See: https://docs.mollie.com/reference/v2/balances-api/list-balance-transactions
-func (bs *BalancesService) GetTransactionsList(
+func (bs *BalancesService) ListTransactions(
 	ctx context.Context,
 	balance string,
 	options *ListBalanceTransactionsOptions,
@@ -289,6 +289,22 @@ func (bs *BalancesService) GetTransactionsList(
 	return bs.listTransactions(ctx, balance, options)
 }
 
+// GetTransactionsList retrieves a list of movements (transactions) for the
+// specified balance.
+//
+// Deprecated: use ListTransactions instead.
+func (bs *BalancesService) GetTransactionsList(
+	ctx context.Context,
+	balance string,
+	options *ListBalanceTransactionsOptions,
+) (
+	res *Response,
+	btl *BalanceTransactionsList,
+	err error,
+) {
+	return bs.ListTransactions(ctx, balance, options)
+}
+
 // GetPrimaryTransactionsList retrieves the list of movements (transactions) for the
 // primary balance of the account.
 //
@@ -309,7 +325,7 @@ func (bs *BalancesService) get(ctx context.Context, balance string) (res *Respon
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &b); err != nil {
+	if err = res.decode(&b); err != nil {
 		return
 	}
 
@@ -326,7 +342,7 @@ func (bs *BalancesService) list(
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &lb); err != nil {
+	if err = res.decode(&lb); err != nil {
 		return
 	}
 
@@ -345,7 +361,7 @@ func (bs *BalancesService) getReport(
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &br); err != nil {
+	if err = res.decode(&br); err != nil {
 		return
 	}
 
@@ -364,7 +380,7 @@ func (bs *BalancesService) listTransactions(
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &btl); err != nil {
+	if err = res.decode(&btl); err != nil {
 		return
 	}
 
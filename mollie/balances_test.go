@@ -75,7 +75,7 @@ func TestBalancesService_Get(t *testing.T) {
 				"bal_gVMhHKqSSRYJyPsuoPNFH",
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding Balance: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			encodingHandler,
 			noPre,
 		},
@@ -103,6 +103,7 @@ func TestBalancesService_Get(t *testing.T) {
 			} else {
 				assert.Nil(t, err)
 				assert.IsType(t, &Balance{}, capture)
+				assert.Equal(t, LiveMode, capture.Mode)
 				assert.EqualValues(t, c.args.ctx, res.Request.Context())
 				assert.IsType(t, &http.Response{}, res.Response)
 			}
@@ -170,7 +171,7 @@ func TestBalancesService_Primary(t *testing.T) {
 				context.Background(),
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding Balance: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			encodingHandler,
 			noPre,
 		},
@@ -267,7 +268,7 @@ func TestBalancesService_List(t *testing.T) {
 				nil,
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding BalancesList: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			encodingHandler,
 			noPre,
 		},
@@ -369,7 +370,7 @@ func TestBalancesService_GetReport(t *testing.T) {
 				nil,
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding BalanceReport: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			encodingHandler,
 			noPre,
 		},
@@ -473,7 +474,7 @@ func TestBalancesService_GetPrimaryReport(t *testing.T) {
 				nil,
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding BalanceReport: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			encodingHandler,
 			noPre,
 		},
@@ -578,7 +579,7 @@ func TestBalancesService_ListBalanceTransactions(t *testing.T) {
 				nil,
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding BalanceTransactionsList: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			encodingHandler,
 			noPre,
 		},
@@ -599,7 +600,7 @@ func TestBalancesService_ListBalanceTransactions(t *testing.T) {
 				c.handler,
 			)
 
-			res, balanceReport, err := tClient.Balances.GetTransactionsList(c.args.ctx, c.args.balance, c.args.options)
+			res, balanceReport, err := tClient.Balances.ListTransactions(c.args.ctx, c.args.balance, c.args.options)
 			if c.wantErr {
 				assert.NotNil(t, err)
 				assert.EqualError(t, err, c.err.Error())
@@ -678,7 +679,7 @@ func TestBalancesService_ListPrimaryBalanceTransactions(t *testing.T) {
 				nil,
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding BalanceTransactionsList: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			encodingHandler,
 			noPre,
 		},
@@ -0,0 +1,97 @@
+package mollie
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// CacheEntry describes a cached response body and the ETag used to revalidate it.
+type CacheEntry struct {
+	ETag    string
+	Content []byte
+}
+
+// Cache stores the responses of GET requests keyed by their full request url,
+// query parameters included.
+//
+// Implementations must be safe for concurrent use.
+type Cache interface {
+	Get(key string) (*CacheEntry, bool)
+	Set(key string, entry *CacheEntry)
+}
+
+// MemoryCache is an in memory Cache implementation without eviction.
+type MemoryCache struct {
+	mu      sync.RWMutex
+	entries map[string]*CacheEntry
+}
+
+// NewMemoryCache returns an empty in memory cache.
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{entries: make(map[string]*CacheEntry)}
+}
+
+// Get returns the entry stored for the given key.
+func (mc *MemoryCache) Get(key string) (*CacheEntry, bool) {
+	mc.mu.RLock()
+	defer mc.mu.RUnlock()
+
+	e, ok := mc.entries[key]
+
+	return e, ok
+}
+
+// Set stores the entry for the given key, replacing any previous entry.
+func (mc *MemoryCache) Set(key string, entry *CacheEntry) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+
+	mc.entries[key] = entry
+}
+
+// WithCache enables the ETag based caching of GET requests.
+//
+// The ETag returned by Mollie is sent as If-None-Match header on the following
+// requests to the same url, when Mollie answers with 304 Not Modified the cached
+// content is returned instead. Passing nil disables the cache.
+func (c *Client) WithCache(store Cache) {
+	c.cache = store
+}
+
+// cached returns the cache entry for the request and sets the
+// If-None-Match header when one is found.
+func (c *Client) cached(req *http.Request) *CacheEntry {
+	if c.cache == nil || req.Method != http.MethodGet {
+		return nil
+	}
+
+	e, ok := c.cache.Get(req.URL.String())
+	if !ok || e == nil || e.ETag == "" {
+		return nil
+	}
+
+	req.Header.Set("If-None-Match", e.ETag)
+
+	return e
+}
+
+// revalidate replaces the content of a not modified response with the cached
+// entry and stores the successful responses carrying an ETag.
+func (c *Client) revalidate(req *http.Request, res *Response, e *CacheEntry) {
+	if c.cache == nil || req.Method != http.MethodGet {
+		return
+	}
+
+	if res.StatusCode == http.StatusNotModified && e != nil {
+		res.content = e.Content
+		res.Body = io.NopCloser(bytes.NewReader(e.Content))
+
+		return
+	}
+
+	if etag := res.Header.Get("ETag"); etag != "" && res.StatusCode == http.StatusOK {
+		c.cache.Set(req.URL.String(), &CacheEntry{ETag: etag, Content: res.content})
+	}
+}
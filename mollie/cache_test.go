@@ -0,0 +1,95 @@
+package mollie
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/VictorAvelar/mollie-api-go/v4/testdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_WithCache(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	var calls, revalidated int
+
+	tMux.HandleFunc("/v2/payments/tr_WDqYK6vllg", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+
+		etag := `"` + r.URL.Query().Get("testmode") + `-v1"`
+		if r.Header.Get("If-None-Match") == etag {
+			revalidated++
+			w.WriteHeader(http.StatusNotModified)
+
+			return
+		}
+
+		w.Header().Set("ETag", etag)
+		_, _ = w.Write([]byte(testdata.GetPaymentResponse))
+	})
+
+	tClient.WithCache(NewMemoryCache())
+
+	for i := 0; i < 2; i++ {
+		res, p, err := tClient.Payments.Get(context.Background(), "tr_WDqYK6vllg", nil)
+		require.NoError(t, err)
+		assert.Equal(t, "tr_WDqYK6vllg", p.ID)
+		assert.NotEmpty(t, res.content)
+	}
+
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, 1, revalidated)
+
+	_, p, err := tClient.Payments.Get(context.Background(), "tr_WDqYK6vllg", &PaymentOptions{Testmode: true})
+	require.NoError(t, err)
+	assert.Equal(t, "tr_WDqYK6vllg", p.ID)
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, 1, revalidated, "entries are keyed on the full url")
+}
+
+func TestClient_WithCache_OnlyGetRequests(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	cache := NewMemoryCache()
+	tClient.WithCache(cache)
+
+	tMux.HandleFunc("/v2/payments", func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("If-None-Match"))
+		w.Header().Set("ETag", `"v1"`)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(testdata.GetPaymentResponse))
+	})
+
+	for i := 0; i < 2; i++ {
+		_, _, err := tClient.Payments.Create(context.Background(), CreatePayment{}, nil)
+		require.NoError(t, err)
+	}
+
+	assert.Empty(t, cache.entries)
+}
+
+func TestClient_WithCache_Disabled(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	tMux.HandleFunc("/v2/payments/tr_WDqYK6vllg", func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("If-None-Match"))
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = w.Write([]byte(testdata.GetPaymentResponse))
+	})
+
+	for i := 0; i < 2; i++ {
+		_, _, err := tClient.Payments.Get(context.Background(), "tr_WDqYK6vllg", nil)
+		require.NoError(t, err)
+	}
+}
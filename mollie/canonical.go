@@ -0,0 +1,72 @@
+package mollie
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+)
+
+// CanonicalJSON encodes v as JSON with the object keys sorted and without
+// insignificant whitespace, so equal values always produce the same bytes,
+// whatever the field order of their structs or the iteration order of
+// their maps. Numbers are kept as written, HTML characters are not escaped.
+//
+// Fields are encoded following their json tags first, omitempty included,
+// so omitted fields never reach the output.
+func CanonicalJSON(v any) ([]byte, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("encoding_error: %w", err)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+
+	var generic any
+	if err := dec.Decode(&generic); err != nil {
+		return nil, fmt.Errorf("encoding_error: %w", err)
+	}
+
+	buf := new(bytes.Buffer)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+
+	if err := enc.Encode(generic); err != nil {
+		return nil, fmt.Errorf("encoding_error: %w", err)
+	}
+
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// WithDerivedIdempotencyKeys enables or disables idempotency keys derived
+// from the requests, POST requests sent without idempotency key get one
+// hashed from their method, url and canonical body, see CanonicalJSON.
+//
+// Retrying a create call with the same body then reuses the same key, even
+// across processes, and Mollie answers with the resource created by the first
+// attempt. Keep in mind that two intentionally identical requests, e.g. two
+// payments with the same amount and description, are also deduplicated, add a
+// distinguishing field like an order reference in the metadata to tell them
+// apart. Derived keys replace the ones of the idempotency key generator, keys
+// provided through the context or request options take precedence. It is
+// disabled by default.
+func (c *Client) WithDerivedIdempotencyKeys(enabled bool) {
+	c.deriveIdempotencyKeys = enabled
+}
+
+// derivedIdempotencyKey returns an idempotency key identifying the request,
+// the hash is truncated to fit MaxIdempotencyKeyLength.
+func derivedIdempotencyKey(method, url string, body any) (string, error) {
+	content, err := CanonicalJSON(body)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	h.Write([]byte(method + " " + url + "\n"))
+	h.Write(content)
+
+	return hex.EncodeToString(h.Sum(nil))[:MaxIdempotencyKeyLength], nil
+}
@@ -0,0 +1,76 @@
+package mollie
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalJSON(t *testing.T) {
+	type payment struct {
+		Description string         `json:"description"`
+		Amount      *Amount        `json:"amount,omitempty"`
+		Metadata    map[string]any `json:"metadata,omitempty"`
+		Quantity    int            `json:"quantity,omitempty"`
+	}
+
+	got, err := CanonicalJSON(payment{
+		Description: "Order <12345> & co",
+		Amount:      &Amount{Currency: "EUR", Value: "10.00"},
+		Metadata:    map[string]any{"z": 1.10, "a": []int{3, 1}, "m": map[string]string{"y": "1", "b": "2"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(
+		t,
+		`{"amount":{"currency":"EUR","value":"10.00"},"description":"Order <12345> & co",`+
+			`"metadata":{"a":[3,1],"m":{"b":"2","y":"1"},"z":1.1}}`,
+		string(got),
+	)
+
+	same, err := CanonicalJSON(map[string]any{
+		"metadata":    map[string]any{"m": map[string]string{"b": "2", "y": "1"}, "z": 1.10, "a": []int{3, 1}},
+		"description": "Order <12345> & co",
+		"amount":      map[string]string{"value": "10.00", "currency": "EUR"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, string(got), string(same))
+
+	null, err := CanonicalJSON(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "null", string(null))
+
+	_, err = CanonicalJSON(make(chan int))
+	assert.ErrorContains(t, err, "encoding_error")
+}
+
+func TestClient_WithDerivedIdempotencyKeys(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	key := func(ctx context.Context, method string, body any) string {
+		req, err := tClient.NewAPIRequest(ctx, method, "v2/payments", body)
+		require.NoError(t, err)
+
+		return req.Header.Get(IdempotencyKeyHeader)
+	}
+
+	first := CreatePayment{Description: "Order #12345", Amount: &Amount{Currency: "EUR", Value: "10.00"}}
+	other := CreatePayment{Description: "Order #12346", Amount: &Amount{Currency: "EUR", Value: "10.00"}}
+
+	generated := key(context.Background(), http.MethodPost, first)
+	assert.NotEqual(t, generated, key(context.Background(), http.MethodPost, first))
+
+	tClient.WithDerivedIdempotencyKeys(true)
+
+	derived := key(context.Background(), http.MethodPost, first)
+	assert.Len(t, derived, MaxIdempotencyKeyLength)
+	assert.Equal(t, derived, key(context.Background(), http.MethodPost, first))
+	assert.NotEqual(t, derived, key(context.Background(), http.MethodPost, other))
+	assert.Empty(t, key(context.Background(), http.MethodGet, nil))
+	assert.Equal(t, "explicit_key", key(WithIdempotencyKey(context.Background(), "explicit_key"), http.MethodPost, first))
+}
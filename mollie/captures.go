@@ -2,7 +2,6 @@ package mollie
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"time"
 )
@@ -26,11 +25,16 @@ const (
 	CaptureStatusFailed    CaptureStatus = "failed"
 )
 
+// IsFinal reports whether the capture reached a status that will not change anymore.
+func (cs CaptureStatus) IsFinal() bool {
+	return cs == CaptureStatusSucceeded || cs == CaptureStatusFailed
+}
+
 // CreateCapture describes the payload for creating a capture.
 type CreateCapture struct {
-	Description string  `json:"description,omitempty"`
-	Metadata    any     `json:"metadata,omitempty"`
-	Amount      *Amount `json:"amount,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Metadata    Metadata `json:"metadata,omitempty"`
+	Amount      *Amount  `json:"amount,omitempty"`
 	CaptureAccessTokenFields
 }
 
@@ -52,7 +56,7 @@ type Capture struct {
 	ShipmentID       string        `json:"shipmentId,omitempty"`
 	SettlementID     string        `json:"settlementId,omitempty"`
 	CreatedAt        *time.Time    `json:"createdAt,omitempty"`
-	Metadata         any           `json:"metadata,omitempty"`
+	Metadata         Metadata      `json:"metadata,omitempty"`
 	Links            CaptureLinks  `json:"_links,omitempty"`
 	CaptureAccessTokenFields
 }
@@ -70,14 +74,16 @@ type CaptureLinks struct {
 //
 // See: https://docs.mollie.com/reference/v2/captures-api/get-capture#embedding-of-related-resources
 type CaptureOptions struct {
-	Embed []EmbedValue `url:"embed,omitempty"`
+	Embed    []EmbedValue   `url:"embed,omitempty,comma"`
+	Include  []IncludeValue `url:"include,omitempty,comma"`
+	Testmode bool           `url:"testmode,omitempty"`
 }
 
 // CapturesList describes a list of captures.
 type CapturesList struct {
 	Count    int `json:"count,omitempty"`
 	Embedded struct {
-		Captures []*Capture
+		Captures []*Capture `json:"captures,omitempty"`
 	} `json:"_embedded,omitempty"`
 	Links PaginationLinks `json:"_links,omitempty"`
 }
@@ -101,7 +107,7 @@ func (cs *CapturesService) Get(ctx context.Context, payment, capture string, opt
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &c); err != nil {
+	if err = res.decode(&c); err != nil {
 		return
 	}
 
@@ -110,8 +116,17 @@ func (cs *CapturesService) Get(ctx context.Context, payment, capture string, opt
 
 // Create creates a new capture for a payment.
 //
+// Omitting the amount captures the full authorized amount, provide a lower amount
+// to perform a partial capture. Mollie rejects captures for payments that are not
+// in the authorized status, the rejection is returned as a *BaseError.
+//
 // See: https://docs.mollie.com/reference/v2/captures-api/create-capture
-func (cs *CapturesService) Create(ctx context.Context, payment string, capture CreateCapture) (
+func (cs *CapturesService) Create(
+	ctx context.Context,
+	payment string,
+	capture CreateCapture,
+	reqOpts ...RequestOption,
+) (
 	res *Response,
 	c *Capture,
 	err error,
@@ -122,12 +137,12 @@ func (cs *CapturesService) Create(ctx context.Context, payment string, capture C
 		capture.Testmode = true
 	}
 
-	res, err = cs.client.post(ctx, u, capture, nil)
+	res, err = cs.client.post(ctx, u, capture, nil, reqOpts...)
 	if err != nil {
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &c); err != nil {
+	if err = res.decode(&c); err != nil {
 		return
 	}
 
@@ -149,7 +164,7 @@ func (cs *CapturesService) List(ctx context.Context, payment string, options *Ca
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &cl); err != nil {
+	if err = res.decode(&cl); err != nil {
 		return
 	}
 
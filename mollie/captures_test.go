@@ -74,6 +74,28 @@ func TestCapturesService_Get(t *testing.T) {
 			},
 			noPre,
 		},
+		{
+			"get captures includes details and testmode in the query params",
+			args{
+				context.Background(),
+				"tr_WDqYK6vllg",
+				"cpt_4qqhO89gsT",
+				&CaptureOptions{
+					Embed:    []EmbedValue{EmbedPayments},
+					Include:  []IncludeValue{IncludeQrCode},
+					Testmode: true,
+				},
+			},
+			false,
+			nil,
+			func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "GET")
+				testQuery(t, r, "embed=payments&include=details.qrCode&testmode=true")
+
+				_, _ = w.Write([]byte(testdata.GetCaptureResponse))
+			},
+			noPre,
+		},
 		{
 			"get captures returns an http error from the server",
 			args{
@@ -109,7 +131,7 @@ func TestCapturesService_Get(t *testing.T) {
 				nil,
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding Capture: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			encodingHandler,
 			noPre,
 		},
@@ -231,6 +253,22 @@ func TestCapturesService_Create(t *testing.T) {
 			errorHandler,
 			noPre,
 		},
+		{
+			"create captures surfaces the error when the payment is not authorized",
+			args{
+				context.Background(),
+				"tr_WDqYK6vllg",
+				CreateCapture{},
+				nil,
+			},
+			true,
+			fmt.Errorf("422 Unprocessable Entity: The payment is not authorized and can not be captured, affected field: paymentId"),
+			func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				_, _ = w.Write([]byte(testdata.CreateCaptureNotAuthorizedResponse))
+			},
+			noPre,
+		},
 		{
 			"create captures returns an error when creating the request",
 			args{
@@ -253,7 +291,7 @@ func TestCapturesService_Create(t *testing.T) {
 				nil,
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding Capture: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			encodingHandler,
 			noPre,
 		},
@@ -372,7 +410,7 @@ func TestCapturesService_List(t *testing.T) {
 				&CaptureOptions{},
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding CapturesList: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			encodingHandler,
 			noPre,
 		},
@@ -406,3 +444,9 @@ func TestCapturesService_List(t *testing.T) {
 		})
 	}
 }
+
+func TestCaptureStatus_IsFinal(t *testing.T) {
+	assert.False(t, CaptureStatusPending.IsFinal())
+	assert.True(t, CaptureStatusSucceeded.IsFinal())
+	assert.True(t, CaptureStatusFailed.IsFinal())
+}
@@ -3,10 +3,38 @@ package mollie
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
 	"time"
+
+	"github.com/VictorAvelar/mollie-api-go/v4/pkg/pagination"
 )
 
+// ErrIteratorDone is returned by the list iterators once all the
+// available pages have been consumed.
+var ErrIteratorDone = errors.New("iterator done: no more items to retrieve")
+
+// ErrTestModeOnly is returned when trying to use a test mode only endpoint
+// with live credentials.
+var ErrTestModeOnly = errors.New("test mode only: the endpoint is not available in live mode")
+
+// ErrChargebackWithoutPayment is returned when the payment of a chargeback
+// can not be retrieved because it has neither payment id nor payment link.
+var ErrChargebackWithoutPayment = errors.New("chargeback without payment id or payment link")
+
+// ErrProfileUnresolved is returned when the profile of a chargeback can not
+// be resolved because the client is not allowed to retrieve its payment.
+var ErrProfileUnresolved = errors.New("profile unresolved: the credentials can not retrieve the chargeback payments")
+
+// ErrPaginationTruncated is returned along with the partial results when the
+// pagination is stopped on purpose before reaching the end of the list.
+var ErrPaginationTruncated = errors.New("pagination truncated: cutoff reached")
+
 // Chargeback describes a forced transaction reversal initiated by the cardholder's bank.
 type Chargeback struct {
 	Resource         string            `json:"resource,omitempty"`
@@ -21,12 +49,77 @@ type Chargeback struct {
 	ChargebackAccessTokenFields
 }
 
+// Reversed reports whether the chargeback has been reversed.
+func (c *Chargeback) Reversed() bool {
+	return c != nil && c.ReversedAt != nil
+}
+
+// IsReversed reports whether the chargeback has been reversed, it is an alias of Reversed.
+func (c *Chargeback) IsReversed() bool {
+	return c.Reversed()
+}
+
+// Age returns the time elapsed since the chargeback was created, reversed
+// chargebacks included. False is returned when the creation time is unknown.
+func (c *Chargeback) Age() (time.Duration, bool) {
+	if c == nil || c.CreatedAt == nil {
+		return 0, false
+	}
+
+	return time.Since(*c.CreatedAt), true
+}
+
+// ReversalDuration returns the time it took to reverse the chargeback since
+// its creation. False is returned when the chargeback is not reversed or
+// its creation time is unknown.
+func (c *Chargeback) ReversalDuration() (time.Duration, bool) {
+	if c == nil || c.CreatedAt == nil || c.ReversedAt == nil {
+		return 0, false
+	}
+
+	return c.ReversedAt.Sub(*c.CreatedAt), true
+}
+
+// IsCrossCurrency reports whether the chargeback was settled in a different
+// currency than the charged back amount, in that case both amounts can not
+// be combined without applying the exchange rate.
+func (c *Chargeback) IsCrossCurrency() bool {
+	if c == nil || c.Amount == nil || c.SettlementAmount == nil {
+		return false
+	}
+
+	return !c.Amount.SameCurrency(c.SettlementAmount)
+}
+
 // ChargebackReason describes the reason for the chargeback as given by the bank.
+//
+// Older chargebacks are returned without a reason, in that case the
+// Reason field of the chargeback is nil.
 type ChargebackReason struct {
-	Code        string `json:"code,omitempty"`
-	Description string `json:"description,omitempty"`
+	Code        ChargebackReasonCode `json:"code,omitempty"`
+	Description string               `json:"description,omitempty"`
 }
 
+// ChargebackReasonCode is the code used by the bank to explain the chargeback.
+type ChargebackReasonCode string
+
+// Known chargeback reason codes, based on the SEPA return reason codes.
+const (
+	ChargebackAccountIdentifierIncorrect ChargebackReasonCode = "AC01"
+	ChargebackAccountClosed              ChargebackReasonCode = "AC04"
+	ChargebackAccountBlocked             ChargebackReasonCode = "AC06"
+	ChargebackTransactionForbidden       ChargebackReasonCode = "AG01"
+	ChargebackInvalidBankOperationCode   ChargebackReasonCode = "AG02"
+	ChargebackInsufficientFunds          ChargebackReasonCode = "AM04"
+	ChargebackAmountExceedsMaximum       ChargebackReasonCode = "AM05"
+	ChargebackNoMandate                  ChargebackReasonCode = "MD01"
+	ChargebackRefundRequestByEndCustomer ChargebackReasonCode = "MD06"
+	ChargebackDebtorDeceased             ChargebackReasonCode = "MD07"
+	ChargebackNotSpecifiedByCustomer     ChargebackReasonCode = "MS02"
+	ChargebackNotSpecifiedByAgent        ChargebackReasonCode = "MS03"
+	ChargebackSpecificServiceByAgent     ChargebackReasonCode = "SL01"
+)
+
 // ChargebackAccessTokenFields describes the fields to be used to create a chargeback access token.
 type ChargebackAccessTokenFields struct {
 	ProfileID string `json:"profileId,omitempty"`
@@ -40,21 +133,84 @@ type ChargebackLinks struct {
 	Payment       *URL `json:"payment,omitempty"`
 	Settlement    *URL `json:"settlement,omitempty"`
 	Documentation *URL `json:"documentation,omitempty"`
+	extra         *Links
+}
+
+// UnmarshalJSON decodes the links, the ones not described by ChargebackLinks
+// remain available through Link.
+func (l *ChargebackLinks) UnmarshalJSON(data []byte) (err error) {
+	l.extra, err = unmarshalLinks(data, l)
+
+	return err
+}
+
+// Link returns the link with the given name, e.g. "settlement".
+func (l *ChargebackLinks) Link(name string) *URL {
+	return lookupLink(l, l.extra, name)
+}
+
+// Link returns the link of the chargeback with the given name, e.g. "settlement".
+func (c *Chargeback) Link(name string) *URL {
+	if c == nil {
+		return nil
+	}
+
+	return c.Links.Link(name)
 }
 
 // ChargebackOptions describes chargeback endpoint valid query string parameters.
 type ChargebackOptions struct {
-	Include []IncludeValue `url:"include,omitempty"`
-	Embed   []EmbedValue   `url:"embed,omitempty"`
+	Include  []IncludeValue `url:"include,omitempty,comma"`
+	Embed    []EmbedValue   `url:"embed,omitempty,comma"`
+	Testmode bool           `url:"testmode,omitempty"`
 }
 
 // ListChargebacksOptions describes list chargebacks endpoint valid query string parameters.
+//
+// SettlementID is not a query string parameter, when set the chargebacks
+// deducted in the settlement are listed instead, see
+// ChargebacksService.ListForSettlement. It can not be combined with ProfileID.
 type ListChargebacksOptions struct {
-	From      string         `url:"from,omitempty"`
-	Limit     int            `url:"limit,omitempty"`
-	Include   []IncludeValue `url:"include,omitempty"`
-	Embed     []EmbedValue   `url:"embed,omitempty"`
-	ProfileID string         `url:"profileId,omitempty"`
+	From         string         `url:"from,omitempty"`
+	Limit        int            `url:"limit,omitempty"`
+	Sort         SortDirection  `url:"sort,omitempty"`
+	Include      []IncludeValue `url:"include,omitempty,comma"`
+	Embed        []EmbedValue   `url:"embed,omitempty,comma"`
+	ProfileID    string         `url:"profileId,omitempty"`
+	SettlementID string         `url:"-"`
+	Testmode     bool           `url:"testmode,omitempty"`
+}
+
+// uri returns the path listing the chargebacks selected by the options.
+func (o *ListChargebacksOptions) uri() (string, error) {
+	if o == nil || o.SettlementID == "" {
+		return "v2/chargebacks", nil
+	}
+
+	if o.ProfileID != "" {
+		return "", &ValidationError{
+			Field:  "settlementId",
+			Reason: "can not be combined with profileId, settlements already belong to a single profile",
+		}
+	}
+
+	return fmt.Sprintf("v2/settlements/%s/chargebacks", o.SettlementID), nil
+}
+
+// WithPagination returns a copy of the options requesting a page of at most
+// limit chargebacks starting at the chargeback with the given id.
+//
+// Mollie uses cursor based pagination, from is a chargeback id and not a date.
+func (o *ListChargebacksOptions) WithPagination(from string, limit int) *ListChargebacksOptions {
+	opts := ListChargebacksOptions{}
+	if o != nil {
+		opts = *o
+	}
+
+	opts.From = from
+	opts.Limit = limit
+
+	return &opts
 }
 
 // ChargebacksList describes how a list of chargebacks will be retrieved by Mollie.
@@ -80,19 +236,124 @@ func (cs *ChargebacksService) Get(ctx context.Context, payment, chargeback strin
 ) {
 	u := fmt.Sprintf("v2/payments/%s/chargebacks/%s", payment, chargeback)
 
-	res, err = cs.client.get(ctx, u, opts)
-	if err != nil {
-		return
+	return doInto[Chargeback](ctx, cs.client, http.MethodGet, u, opts, nil)
+}
+
+// Create triggers a chargeback on a test mode payment, it allows to test
+// the handling of disputes before going live.
+//
+// Chargebacks can not be created in live mode, ErrTestModeOnly is returned
+// without sending the request when using a live API key or when using an
+// access token without enabling the test mode in the client config or options.
+func (cs *ChargebacksService) Create(
+	ctx context.Context,
+	payment string,
+	opts *ChargebackOptions,
+	reqOpts ...RequestOption,
+) (
+	res *Response,
+	cb *Chargeback,
+	err error,
+) {
+	if !cs.client.inTestMode(opts != nil && opts.Testmode) {
+		return nil, nil, ErrTestModeOnly
 	}
 
-	if err = json.Unmarshal(res.content, &p); err != nil {
-		return
+	u := fmt.Sprintf("v2/payments/%s/chargebacks", payment)
+
+	return doInto[Chargeback](ctx, cs.client, http.MethodPost, u, opts, nil, reqOpts...)
+}
+
+// Payment retrieves the payment the chargeback belongs to, using the payment id
+// of the chargeback or the id found in its payment link.
+func (cs *ChargebacksService) Payment(ctx context.Context, cb *Chargeback) (
+	res *Response,
+	p *Payment,
+	err error,
+) {
+	if cb == nil {
+		return nil, nil, ErrChargebackWithoutPayment
+	}
+
+	id := chargebackPaymentID(cb)
+	if id == "" {
+		return nil, nil, ErrChargebackWithoutPayment
 	}
 
-	return
+	return cs.client.Payments.Get(ctx, id, nil)
 }
 
-// List retrieves a list of chargebacks associated with your account/organization.
+// ChargebackRef identifies a chargeback by its id and the id of its payment.
+type ChargebackRef struct {
+	PaymentID    string
+	ChargebackID string
+}
+
+// GetMany retrieves the referenced chargebacks using at most concurrency
+// simultaneous requests, values lower than 1 retrieve them one by one.
+//
+// Both returned slices follow the order of refs: when retrieving the chargeback
+// at index i fails, the error is found at index i of the errors slice and the
+// chargeback at the same index is left empty. The client retry policy applies
+// to every request, cancelling the context aborts the pending ones.
+func (cs *ChargebacksService) GetMany(ctx context.Context, refs []ChargebackRef, concurrency int) (
+	[]Chargeback,
+	[]error,
+) {
+	cbs := make([]Chargeback, len(refs))
+	errs := make([]error, len(refs))
+
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+
+	for w := 0; w < concurrency && w < len(refs); w++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range jobs {
+				if err := ctx.Err(); err != nil {
+					errs[i] = err
+
+					continue
+				}
+
+				_, cb, err := cs.Get(ctx, refs[i].PaymentID, refs[i].ChargebackID, nil)
+				if err != nil {
+					errs[i] = err
+
+					continue
+				}
+
+				if cb != nil {
+					cbs[i] = *cb
+				}
+			}
+		}()
+	}
+
+	for i := range refs {
+		jobs <- i
+	}
+
+	close(jobs)
+	wg.Wait()
+
+	return cbs, errs
+}
+
+// List retrieves a list of chargebacks associated with your account/organization,
+// or deducted in a settlement when SettlementID is set.
+//
+// Filtering by ProfileID requires an organization or OAuth access token,
+// a *ValidationError is returned without sending the request when the
+// client uses an API key, see Client.WithProfileScopeValidation.
 //
 // See: https://docs.mollie.com/reference/v2/chargebacks-api/list-chargebacks
 func (cs *ChargebacksService) List(ctx context.Context, options *ListChargebacksOptions) (
@@ -100,7 +361,41 @@ func (cs *ChargebacksService) List(ctx context.Context, options *ListChargebacks
 	cl *ChargebacksList,
 	err error,
 ) {
-	return cs.list(ctx, "v2/chargebacks", options)
+	if options != nil {
+		if err = cs.client.validateProfileScope(options.ProfileID); err != nil {
+			return
+		}
+	}
+
+	uri, err := options.uri()
+	if err != nil {
+		return
+	}
+
+	return cs.list(ctx, uri, options)
+}
+
+// ListByProfile retrieves a list of chargebacks associated with the given profile,
+// the remaining options like pagination, include or embed values are preserved.
+//
+// See: https://docs.mollie.com/reference/v2/chargebacks-api/list-chargebacks
+func (cs *ChargebacksService) ListByProfile(ctx context.Context, profileID string, options *ListChargebacksOptions) (
+	res *Response,
+	cl *ChargebacksList,
+	err error,
+) {
+	if profileID == "" {
+		return nil, nil, &ValidationError{Field: "profileId", Reason: "is required"}
+	}
+
+	opts := ListChargebacksOptions{}
+	if options != nil {
+		opts = *options
+	}
+
+	opts.ProfileID = profileID
+
+	return cs.List(ctx, &opts)
 }
 
 // ListForPayment retrieves a list of chargebacks associated with a single payment.
@@ -114,20 +409,440 @@ func (cs *ChargebacksService) ListForPayment(ctx context.Context, payment string
 	return cs.list(ctx, fmt.Sprintf("v2/payments/%s/chargebacks", payment), options)
 }
 
+// ListForSettlement retrieves a list of chargebacks deducted in a settlement,
+// the results are paginated using the From and Limit options.
+//
+// See: https://docs.mollie.com/reference/v2/settlements-api/list-settlement-chargebacks
+func (cs *ChargebacksService) ListForSettlement(ctx context.Context, settlement string, options *ListChargebacksOptions) (
+	res *Response,
+	cl *ChargebacksList,
+	err error,
+) {
+	return cs.list(ctx, fmt.Sprintf("v2/settlements/%s/chargebacks", settlement), options)
+}
+
 // encapsulates the shared list methods logic.
 func (cs *ChargebacksService) list(ctx context.Context, uri string, options interface{}) (
 	res *Response,
 	cl *ChargebacksList,
 	err error,
 ) {
-	res, err = cs.client.get(ctx, uri, options)
+	return doInto[ChargebacksList](ctx, cs.client, http.MethodGet, uri, options, nil)
+}
+
+// HasMore reports whether the list has a next page, a list without next
+// link is the final page.
+func (cl *ChargebacksList) HasMore() bool {
+	return cl != nil && cl.Links.Next != nil && cl.Links.Next.Href != ""
+}
+
+// NextPageURL returns the url of the next page and false when the list is
+// the final page.
+func (cl *ChargebacksList) NextPageURL() (string, bool) {
+	if !cl.HasMore() {
+		return "", false
+	}
+
+	return cl.Links.Next.Href, true
+}
+
+// ListNext retrieves the page following cl using its next link, which gives
+// manual control over the pagination without using ListAll.
+//
+// The next link is resolved against the client base url, so pages are always
+// requested from the configured Mollie host. When cl is the final page
+// ErrIteratorDone is returned.
+func (cs *ChargebacksService) ListNext(ctx context.Context, cl *ChargebacksList) (
+	res *Response,
+	next *ChargebacksList,
+	err error,
+) {
+	href, ok := cl.NextPageURL()
+	if !ok {
+		return nil, nil, ErrIteratorDone
+	}
+
+	u, err := url.Parse(href)
 	if err != nil {
-		return
+		return nil, nil, fmt.Errorf("url_parsing_error: %w", err)
 	}
 
-	if err = json.Unmarshal(res.content, &cl); err != nil {
-		return
+	return cs.list(ctx, strings.TrimPrefix(u.RequestURI(), "/"), nil)
+}
+
+// ChargebacksIterator walks through every page of a chargebacks list.
+//
+// Pages are requested lazily, a new page is only retrieved from Mollie
+// once all the chargebacks of the previous one have been consumed.
+type ChargebacksIterator struct {
+	cs      *ChargebacksService
+	uri     string
+	options ListChargebacksOptions
+	page    []*Chargeback
+	last    bool
+	err     error
+}
+
+// ListAll returns an iterator over all the chargebacks associated with your
+// account/organization, transparently following the pagination links.
+//
+// The provided options are used for every page request, this means Limit
+// controls the size of each page and From the starting point of the iteration.
+// When Limit is not set the pages are requested with MaxListLimit to minimize
+// the number of round-trips. SettlementID selects the chargebacks of a
+// settlement and ProfileID is validated like they are for List.
+//
+// See: https://docs.mollie.com/reference/v2/chargebacks-api/list-chargebacks
+func (cs *ChargebacksService) ListAll(options *ListChargebacksOptions) *ChargebacksIterator {
+	uri, err := options.uri()
+
+	it := cs.iterator(uri, options)
+	it.err = err
+
+	if it.err == nil {
+		it.err = cs.client.validateProfileScope(it.options.ProfileID)
+	}
+
+	return it
+}
+
+// ListUntil retrieves the chargebacks associated with your account/organization
+// created at or after the cutoff, following the pagination links as needed.
+//
+// Mollie lists the newest chargebacks first, so the iteration stops as soon as
+// a chargeback created before the cutoff is found. In that case the chargebacks
+// collected so far are returned along with ErrPaginationTruncated, reaching the
+// end of the list returns a nil error. The Sort option is ignored, as the
+// cutoff relies on the newest first order.
+func (cs *ChargebacksService) ListUntil(ctx context.Context, options *ListChargebacksOptions, cutoff time.Time) (
+	[]*Chargeback,
+	error,
+) {
+	var opts ListChargebacksOptions
+	if options != nil {
+		opts = *options
+	}
+
+	opts.Sort = ""
+
+	var cbs []*Chargeback
+
+	it := cs.ListAll(&opts)
+
+	for {
+		cb, err := it.Next(ctx)
+		if errors.Is(err, ErrIteratorDone) {
+			return cbs, nil
+		}
+
+		if err != nil {
+			return cbs, err
+		}
+
+		if cb.CreatedAt != nil && cb.CreatedAt.Before(cutoff) {
+			return cbs, ErrPaginationTruncated
+		}
+
+		cbs = append(cbs, cb)
+	}
+}
+
+// ListBetween retrieves the chargebacks associated with your account/organization
+// created within the [from, to] window.
+//
+// Mollie does not filter chargebacks by date, the window is applied on the
+// client side on top of ListUntil using from as cutoff: every page newer than
+// the window is still requested, one request per page, so a window far in the
+// past can be expensive. Pages older than the window are never requested,
+// reaching a chargeback created before from ends the listing successfully.
+// Like ListUntil, chargebacks without creation date are kept and the Sort
+// option is ignored.
+func (cs *ChargebacksService) ListBetween(ctx context.Context, from, to time.Time, options *ListChargebacksOptions) (
+	[]*Chargeback,
+	error,
+) {
+	cbs, err := cs.ListUntil(ctx, options, from)
+	if errors.Is(err, ErrPaginationTruncated) {
+		err = nil
+	}
+
+	var window []*Chargeback
+
+	for _, cb := range cbs {
+		if cb.CreatedAt == nil || !cb.CreatedAt.After(to) {
+			window = append(window, cb)
+		}
+	}
+
+	return window, err
+}
+
+// Export writes all the chargebacks associated with your account/organization
+// to w as newline delimited JSON, one chargeback per line, following the
+// pagination links, and returns the number of chargebacks written.
+//
+// Chargebacks are written as soon as their page is retrieved, so the whole
+// list is never held in memory. When w has a Flush method, e.g. *bufio.Writer,
+// it is flushed after every page. Cancelling the context stops the export
+// between two chargebacks, the count of the ones already written is returned
+// along with the error.
+func (cs *ChargebacksService) Export(ctx context.Context, options *ListChargebacksOptions, w io.Writer) (int, error) {
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+
+	flusher, _ := w.(interface{ Flush() error })
+
+	var n int
+
+	it := cs.ListAll(options)
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return n, err
+		}
+
+		cb, err := it.Next(ctx)
+		if errors.Is(err, ErrIteratorDone) {
+			return n, nil
+		}
+
+		if err != nil {
+			return n, err
+		}
+
+		if err := enc.Encode(cb); err != nil {
+			return n, fmt.Errorf("encoding_error: %w", err)
+		}
+
+		n++
+
+		if len(it.page) == 0 && flusher != nil {
+			if err := flusher.Flush(); err != nil {
+				return n, err
+			}
+		}
+	}
+}
+
+// groupedProfileConcurrency bounds the simultaneous payment lookups of
+// ListGroupedByProfile.
+const groupedProfileConcurrency = 4
+
+// ListGroupedByProfile retrieves all the chargebacks associated with your
+// account/organization, following the pagination links, and groups them by
+// the id of the profile they belong to.
+//
+// Chargebacks do not expose their profile, it is resolved from the payment
+// of each chargeback. Every payment is retrieved once, no matter how many
+// chargebacks it has, using a few simultaneous requests. When the client
+// credentials are not allowed to retrieve the payments, e.g. an organization
+// access token without the payments.read scope, ErrProfileUnresolved is
+// returned along with the Mollie error.
+func (cs *ChargebacksService) ListGroupedByProfile(ctx context.Context, options *ListChargebacksOptions) (
+	map[string][]*Chargeback,
+	error,
+) {
+	var cbs []*Chargeback
+
+	profiles := make(map[string]string)
+
+	it := cs.ListAll(options)
+
+	for {
+		cb, err := it.Next(ctx)
+		if errors.Is(err, ErrIteratorDone) {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		id := chargebackPaymentID(cb)
+		if id == "" {
+			return nil, fmt.Errorf("%w: chargeback %s", ErrChargebackWithoutPayment, cb.ID)
+		}
+
+		if _, ok := profiles[id]; !ok {
+			profiles[id] = cb.ProfileID
+		}
+
+		cbs = append(cbs, cb)
+	}
+
+	if err := cs.resolveProfiles(ctx, profiles); err != nil {
+		return nil, err
+	}
+
+	grouped := make(map[string][]*Chargeback)
+
+	for _, cb := range cbs {
+		profile := profiles[chargebackPaymentID(cb)]
+		grouped[profile] = append(grouped[profile], cb)
+	}
+
+	return grouped, nil
+}
+
+// resolveProfiles fills the unknown profile ids of the payments, keyed by
+// payment id, retrieving each payment once.
+func (cs *ChargebacksService) resolveProfiles(ctx context.Context, profiles map[string]string) error {
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	jobs := make(chan string)
+
+	for w := 0; w < groupedProfileConcurrency; w++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for id := range jobs {
+				_, p, err := cs.client.Payments.Get(ctx, id, nil)
+				if err == nil && (p == nil || p.ProfileID == "") {
+					err = fmt.Errorf("%w: payment %s without profile", ErrProfileUnresolved, id)
+				}
+
+				if errors.Is(err, ErrUnauthorized) || errors.Is(err, ErrForbidden) {
+					err = fmt.Errorf("%w: %w", ErrProfileUnresolved, err)
+				}
+
+				if err != nil {
+					once.Do(func() {
+						firstErr = err
+
+						cancel()
+					})
+
+					continue
+				}
+
+				mu.Lock()
+				profiles[id] = p.ProfileID
+				mu.Unlock()
+			}
+		}()
+	}
+
+	var pending []string
+
+	for id, profile := range profiles {
+		if profile == "" {
+			pending = append(pending, id)
+		}
+	}
+
+	for _, id := range pending {
+		if ctx.Err() != nil {
+			break
+		}
+
+		jobs <- id
+	}
+
+	close(jobs)
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+
+	return ctx.Err()
+}
+
+// chargebackPaymentID returns the id of the chargeback payment, taken from
+// its payment link when the payment id is missing.
+func chargebackPaymentID(cb *Chargeback) string {
+	if cb.PaymentID != "" {
+		return cb.PaymentID
+	}
+
+	return cb.Links.Payment.ResourceID()
+}
+
+// Next returns the next chargeback in the list, fetching a new page when required.
+//
+// When all the chargebacks have been returned ErrIteratorDone is returned.
+func (it *ChargebacksIterator) Next(ctx context.Context) (*Chargeback, error) {
+	if it.err != nil {
+		return nil, it.err
+	}
+
+	for len(it.page) == 0 {
+		if it.last {
+			return nil, ErrIteratorDone
+		}
+
+		if err := it.fetch(ctx); err != nil {
+			it.err = err
+
+			return nil, err
+		}
+	}
+
+	cb := it.page[0]
+	it.page = it.page[1:]
+
+	return cb, nil
+}
+
+// Err returns the first error found while iterating, if any.
+//
+// Reaching the end of the list is not considered an error.
+func (it *ChargebacksIterator) Err() error {
+	return it.err
+}
+
+func (it *ChargebacksIterator) fetch(ctx context.Context) error {
+	_, cl, err := it.cs.list(ctx, it.uri, &it.options)
+	if err != nil {
+		return err
+	}
+
+	it.page = cl.Embedded.Chargebacks
+
+	if cl.Links.Next == nil {
+		it.last = true
+
+		return nil
+	}
+
+	from, err := pagination.ExtractFromQueryParam(cl.Links.Next.Href)
+	if err != nil {
+		return err
+	}
+
+	if from == "" {
+		it.last = true
+	}
+
+	it.options.From = from
+
+	return nil
+}
+
+// encapsulates the shared iterator initialization logic.
+func (cs *ChargebacksService) iterator(uri string, options *ListChargebacksOptions) *ChargebacksIterator {
+	it := &ChargebacksIterator{
+		cs:  cs,
+		uri: uri,
+	}
+
+	if options != nil {
+		it.options = *options
+	}
+
+	if it.options.Limit == 0 {
+		it.options.Limit = MaxListLimit
 	}
 
-	return
+	return it
 }
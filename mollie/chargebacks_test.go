@@ -2,13 +2,19 @@ package mollie
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/VictorAvelar/mollie-api-go/v4/testdata"
+	"github.com/google/go-querystring/query"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestChargebacksService_Get(t *testing.T) {
@@ -97,7 +103,7 @@ func TestChargebacksService_Get(t *testing.T) {
 				},
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding Chargeback: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			encodingHandler,
 			noPre,
 		},
@@ -131,6 +137,77 @@ func TestChargebacksService_Get(t *testing.T) {
 	}
 }
 
+func TestChargeback_Reason(t *testing.T) {
+	cases := []struct {
+		name    string
+		content string
+		want    *ChargebackReason
+	}{
+		{
+			"the reason is decoded when present",
+			testdata.GetChargebackResponse,
+			&ChargebackReason{
+				Code:        ChargebackAccountIdentifierIncorrect,
+				Description: "Account identifier incorrect (i.e. invalid IBAN)",
+			},
+		},
+		{
+			"older chargebacks without reason are left nil",
+			`{"resource":"chargeback","id":"chb_n9z0tp","paymentId":"tr_WDqYK6vllg"}`,
+			nil,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var cb Chargeback
+			require.Nil(t, json.Unmarshal([]byte(c.content), &cb))
+			assert.Equal(t, c.want, cb.Reason)
+		})
+	}
+}
+
+func TestChargeback_IsCrossCurrency(t *testing.T) {
+	cases := []struct {
+		name string
+		cb   *Chargeback
+		want bool
+	}{
+		{
+			"settlements in the same currency",
+			&Chargeback{
+				Amount:           &Amount{Currency: "EUR", Value: "43.38"},
+				SettlementAmount: &Amount{Currency: "EUR", Value: "-43.38"},
+			},
+			false,
+		},
+		{
+			"settlements in another currency",
+			&Chargeback{
+				Amount:           &Amount{Currency: "USD", Value: "43.38"},
+				SettlementAmount: &Amount{Currency: "EUR", Value: "-39.12"},
+			},
+			true,
+		},
+		{
+			"chargebacks without settlement amount",
+			&Chargeback{Amount: &Amount{Currency: "USD", Value: "43.38"}},
+			false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.want, c.cb.IsCrossCurrency())
+
+			if c.want {
+				_, err := c.cb.Amount.Add(c.cb.SettlementAmount)
+				assert.ErrorIs(t, err, ErrCurrencyMismatch)
+			}
+		})
+	}
+}
+
 func TestChargebacksService_List(t *testing.T) {
 	setEnv()
 	defer unsetEnv()
@@ -190,6 +267,64 @@ func TestChargebacksService_List(t *testing.T) {
 			},
 			noPre,
 		},
+		{
+			"list chargebacks with testmode enabled",
+			args{
+				context.Background(),
+				&ListChargebacksOptions{
+					ProfileID: "pfl_QkEhN94Ba",
+					Testmode:  true,
+				},
+			},
+			false,
+			nil,
+			func(w http.ResponseWriter, r *http.Request) {
+				testHeader(t, r, AuthHeader, "Bearer token_X12b31ggg23")
+				testMethod(t, r, "GET")
+				testQuery(t, r, "profileId=pfl_QkEhN94Ba&testmode=true")
+
+				_, _ = w.Write([]byte(testdata.ListChargebacksResponse))
+			},
+			noPre,
+		},
+		{
+			"list chargebacks with testmode disabled omits the parameter",
+			args{
+				context.Background(),
+				&ListChargebacksOptions{
+					ProfileID: "pfl_QkEhN94Ba",
+					Testmode:  false,
+				},
+			},
+			false,
+			nil,
+			func(w http.ResponseWriter, r *http.Request) {
+				testHeader(t, r, AuthHeader, "Bearer token_X12b31ggg23")
+				testMethod(t, r, "GET")
+				testQuery(t, r, "profileId=pfl_QkEhN94Ba")
+
+				_, _ = w.Write([]byte(testdata.ListChargebacksResponse))
+			},
+			noPre,
+		},
+		{
+			"list chargebacks with testmode and an access token sends a single parameter",
+			args{
+				context.Background(),
+				&ListChargebacksOptions{
+					Testmode: true,
+				},
+			},
+			false,
+			nil,
+			func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "GET")
+				testQuery(t, r, "testmode=true")
+
+				_, _ = w.Write([]byte(testdata.ListChargebacksResponse))
+			},
+			setAccessToken,
+		},
 		{
 			"list chargebacks return an http error from the remote server",
 			args{
@@ -219,7 +354,7 @@ func TestChargebacksService_List(t *testing.T) {
 				nil,
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding ChargebacksList: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			encodingHandler,
 			noPre,
 		},
@@ -338,7 +473,7 @@ func TestChargebacksService_ListForPayment(t *testing.T) {
 				nil,
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding ChargebacksList: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			encodingHandler,
 			noPre,
 		},
@@ -368,3 +503,1073 @@ func TestChargebacksService_ListForPayment(t *testing.T) {
 		})
 	}
 }
+
+func TestChargebacksService_ListAll(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+
+	cases := []struct {
+		name    string
+		options *ListChargebacksOptions
+		want    []string
+		wantErr bool
+		err     error
+		handler http.HandlerFunc
+	}{
+		{
+			"list all chargebacks following the pagination links",
+			&ListChargebacksOptions{
+				Limit: 1,
+			},
+			[]string{"chb_n9z0tp", "chb_xvb2kq"},
+			false,
+			nil,
+			func(w http.ResponseWriter, r *http.Request) {
+				testHeader(t, r, AuthHeader, "Bearer token_X12b31ggg23")
+				testMethod(t, r, "GET")
+
+				switch r.URL.Query().Get("from") {
+				case "":
+					testQuery(t, r, "limit=1")
+					_, _ = w.Write([]byte(testdata.ListChargebacksFirstPageResponse))
+				case "chb_xvb2kq":
+					testQuery(t, r, "from=chb_xvb2kq&limit=1")
+					_, _ = w.Write([]byte(testdata.ListChargebacksLastPageResponse))
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			},
+		},
+		{
+			"list all chargebacks returns an http error from the remote server",
+			nil,
+			nil,
+			true,
+			fmt.Errorf("500 Internal Server Error: An internal server error occurred while processing your request."),
+			errorHandler,
+		},
+		{
+			"list all chargebacks fails on empty pages",
+			nil,
+			nil,
+			true,
+			fmt.Errorf("mollie: decoding ChargebacksList: %w", ErrEmptyResponse),
+			func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/hal+json")
+				w.WriteHeader(http.StatusOK)
+			},
+		},
+	}
+
+	for _, c := range cases {
+		setup()
+		defer teardown()
+		t.Run(c.name, func(t *testing.T) {
+			tMux.HandleFunc("/v2/chargebacks", c.handler)
+
+			it := tClient.Chargebacks.ListAll(c.options)
+
+			var got []string
+
+			for {
+				cb, err := it.Next(context.Background())
+				if err != nil {
+					if c.wantErr {
+						assert.EqualError(t, err, c.err.Error())
+						assert.EqualError(t, it.Err(), c.err.Error())
+					} else {
+						assert.ErrorIs(t, err, ErrIteratorDone)
+						assert.Nil(t, it.Err())
+					}
+
+					break
+				}
+
+				got = append(got, cb.ID)
+			}
+
+			assert.Equal(t, c.want, got)
+		})
+	}
+}
+
+func TestChargebacksService_ListNext(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	tMux.HandleFunc("/v2/chargebacks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		switch r.URL.Query().Get("from") {
+		case "":
+			testQuery(t, r, "limit=1")
+			_, _ = w.Write([]byte(testdata.ListChargebacksFirstPageResponse))
+		case "chb_xvb2kq":
+			testQuery(t, r, "from=chb_xvb2kq&limit=1")
+			_, _ = w.Write([]byte(testdata.ListChargebacksLastPageResponse))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	_, first, err := tClient.Chargebacks.List(context.Background(), &ListChargebacksOptions{Limit: 1})
+	require.Nil(t, err)
+	assert.True(t, first.HasMore())
+
+	href, ok := first.NextPageURL()
+	assert.True(t, ok)
+	assert.Equal(t, "https://api.mollie.com/v2/chargebacks?from=chb_xvb2kq&limit=1", href)
+
+	_, last, err := tClient.Chargebacks.ListNext(context.Background(), first)
+	require.Nil(t, err)
+	require.Len(t, last.Embedded.Chargebacks, 1)
+	assert.Equal(t, "chb_xvb2kq", last.Embedded.Chargebacks[0].ID)
+	assert.False(t, last.HasMore())
+
+	href, ok = last.NextPageURL()
+	assert.False(t, ok)
+	assert.Empty(t, href)
+
+	res, next, err := tClient.Chargebacks.ListNext(context.Background(), last)
+	assert.ErrorIs(t, err, ErrIteratorDone)
+	assert.Nil(t, res)
+	assert.Nil(t, next)
+
+	var missing *ChargebacksList
+	assert.False(t, missing.HasMore())
+}
+
+func TestListChargebacksOptions_WithPagination(t *testing.T) {
+	var nilOpts *ListChargebacksOptions
+
+	opts := &ListChargebacksOptions{ProfileID: "pfl_QkEhN94Ba"}
+
+	v, err := query.Values(opts.WithPagination("chb_xvb2kq", 5))
+	require.NoError(t, err)
+	assert.Equal(t, "from=chb_xvb2kq&limit=5&profileId=pfl_QkEhN94Ba", v.Encode())
+	assert.Empty(t, opts.From)
+
+	v, err = query.Values(nilOpts.WithPagination("", 10))
+	require.NoError(t, err)
+	assert.Equal(t, "limit=10", v.Encode())
+}
+
+func TestChargebacksService_ListUntil(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+
+	cases := []struct {
+		name    string
+		cutoff  time.Time
+		want    []string
+		err     error
+		handler http.HandlerFunc
+	}{
+		{
+			"the pagination stops at the first chargeback before the cutoff",
+			time.Date(2018, 3, 13, 0, 0, 0, 0, time.UTC),
+			[]string{"chb_n9z0tp"},
+			ErrPaginationTruncated,
+			nil,
+		},
+		{
+			"the whole list is returned when the cutoff is not reached",
+			time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC),
+			[]string{"chb_n9z0tp", "chb_xvb2kq"},
+			nil,
+			nil,
+		},
+		{
+			"errors are returned along with the partial results",
+			time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC),
+			nil,
+			fmt.Errorf("500 Internal Server Error: An internal server error occurred while processing your request."),
+			errorHandler,
+		},
+	}
+
+	for _, c := range cases {
+		setup()
+		defer teardown()
+		t.Run(c.name, func(t *testing.T) {
+			handler := c.handler
+			if handler == nil {
+				handler = func(w http.ResponseWriter, r *http.Request) {
+					switch r.URL.Query().Get("from") {
+					case "":
+						_, _ = w.Write([]byte(testdata.ListChargebacksFirstPageResponse))
+					case "chb_xvb2kq":
+						_, _ = w.Write([]byte(testdata.ListChargebacksLastPageResponse))
+					default:
+						w.WriteHeader(http.StatusNotFound)
+					}
+				}
+			}
+			tMux.HandleFunc("/v2/chargebacks", handler)
+
+			cbs, err := tClient.Chargebacks.ListUntil(context.Background(), &ListChargebacksOptions{Limit: 1}, c.cutoff)
+
+			var got []string
+			for _, cb := range cbs {
+				got = append(got, cb.ID)
+			}
+
+			assert.Equal(t, c.want, got)
+			if c.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.EqualError(t, err, c.err.Error())
+			}
+		})
+	}
+}
+
+func TestChargebacksService_GetMany(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	var active, peak int32
+
+	tMux.HandleFunc("/v2/payments/", func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&active, 1)
+		defer atomic.AddInt32(&active, -1)
+
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+
+		time.Sleep(10 * time.Millisecond)
+
+		parts := strings.Split(r.URL.Path, "/")
+		id := parts[len(parts)-1]
+
+		if id == "chb_missing" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(testdata.NotFoundErrorResponse))
+
+			return
+		}
+
+		_, _ = fmt.Fprintf(w, `{"resource":"chargeback","id":%q,"paymentId":%q}`, id, parts[3])
+	})
+
+	refs := []ChargebackRef{
+		{"tr_WDqYK6vllg", "chb_n9z0tp"},
+		{"tr_WDqYK6vllg", "chb_missing"},
+		{"tr_7UhSN1zuXS", "chb_xvb2kq"},
+		{"tr_7UhSN1zuXS", "chb_ls7ahg"},
+	}
+
+	cbs, errs := tClient.Chargebacks.GetMany(context.Background(), refs, 2)
+
+	require.Len(t, cbs, len(refs))
+	require.Len(t, errs, len(refs))
+
+	for i, ref := range refs {
+		if ref.ChargebackID == "chb_missing" {
+			var apiErr *BaseError
+			assert.ErrorAs(t, errs[i], &apiErr)
+			assert.Empty(t, cbs[i].ID)
+
+			continue
+		}
+
+		assert.Nil(t, errs[i])
+		assert.Equal(t, ref.ChargebackID, cbs[i].ID)
+		assert.Equal(t, ref.PaymentID, cbs[i].PaymentID)
+	}
+
+	assert.LessOrEqual(t, atomic.LoadInt32(&peak), int32(2))
+}
+
+func TestChargebacksService_GetMany_Canceled(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	tMux.HandleFunc("/v2/payments/", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request should be sent once the context is canceled")
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	cbs, errs := tClient.Chargebacks.GetMany(ctx, []ChargebackRef{
+		{"tr_WDqYK6vllg", "chb_n9z0tp"},
+		{"tr_7UhSN1zuXS", "chb_xvb2kq"},
+	}, 0)
+
+	require.Len(t, cbs, 2)
+
+	for _, err := range errs {
+		assert.ErrorIs(t, err, context.Canceled)
+	}
+}
+
+func TestChargebacksService_List_ProfileScope(t *testing.T) {
+	cases := []struct {
+		name    string
+		auth    string
+		enabled bool
+		err     error
+	}{
+		{
+			"profile filters are rejected for api keys",
+			"test_dHar4XY7LxsDOtmnkVtjNVWXLSlXsM",
+			true,
+			&ValidationError{
+				Field:  "profileId",
+				Reason: "can only be used with organization or OAuth access tokens, API keys are bound to a single profile",
+			},
+		},
+		{
+			"profile filters are accepted for access tokens",
+			"access_Wwvu7egPcJLLJ9Kb7J632x8wJ2zMeJ",
+			true,
+			nil,
+		},
+		{
+			"the validation can be disabled",
+			"live_dHar4XY7LxsDOtmnkVtjNVWXLSlXsM",
+			false,
+			nil,
+		},
+	}
+
+	for _, c := range cases {
+		setup()
+		defer teardown()
+
+		t.Run(c.name, func(t *testing.T) {
+			var sent bool
+
+			tMux.HandleFunc("/v2/chargebacks", func(w http.ResponseWriter, r *http.Request) {
+				sent = true
+				_, _ = w.Write([]byte(testdata.ListChargebacksResponse))
+			})
+
+			require.NoError(t, tClient.WithAuthenticationValue(c.auth))
+			tClient.WithProfileScopeValidation(c.enabled)
+
+			opts := &ListChargebacksOptions{ProfileID: "pfl_QkEhN94Ba"}
+
+			_, _, err := tClient.Chargebacks.List(context.Background(), opts)
+			_, iterErr := tClient.Chargebacks.ListAll(opts).Next(context.Background())
+			_, untilErr := tClient.Chargebacks.ListUntil(context.Background(), opts, time.Time{})
+
+			if c.err != nil {
+				assert.Equal(t, c.err, err)
+				assert.Equal(t, c.err, iterErr)
+				assert.Equal(t, c.err, untilErr)
+				assert.False(t, sent)
+			} else {
+				assert.Nil(t, err)
+				assert.Nil(t, iterErr)
+				assert.Nil(t, untilErr)
+				assert.True(t, sent)
+			}
+		})
+	}
+}
+
+func TestChargebacksService_ListByProfile(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+
+	cases := []struct {
+		name      string
+		profileID string
+		options   *ListChargebacksOptions
+		query     string
+		err       error
+	}{
+		{
+			"the profile id is added to the options",
+			"pfl_QkEhN94Ba",
+			nil,
+			"profileId=pfl_QkEhN94Ba",
+			nil,
+		},
+		{
+			"the remaining options are preserved",
+			"pfl_QkEhN94Ba",
+			&ListChargebacksOptions{
+				From:      "chb_xvb2kq",
+				Limit:     5,
+				Embed:     []EmbedValue{EmbedPayments},
+				ProfileID: "pfl_other",
+			},
+			"embed=payments&from=chb_xvb2kq&limit=5&profileId=pfl_QkEhN94Ba",
+			nil,
+		},
+		{
+			"the profile id is required",
+			"",
+			nil,
+			"",
+			&ValidationError{Field: "profileId", Reason: "is required"},
+		},
+	}
+
+	for _, c := range cases {
+		setup()
+		defer teardown()
+
+		t.Run(c.name, func(t *testing.T) {
+			tMux.HandleFunc("/v2/chargebacks", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "GET")
+				testQuery(t, r, c.query)
+				_, _ = w.Write([]byte(testdata.ListChargebacksResponse))
+			})
+
+			_, cl, err := tClient.Chargebacks.ListByProfile(context.Background(), c.profileID, c.options)
+			if c.err != nil {
+				assert.Equal(t, c.err, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, 3, cl.Count)
+
+			if c.options != nil {
+				assert.Equal(t, "pfl_other", c.options.ProfileID)
+			}
+		})
+	}
+}
+
+func TestChargebacksService_ListForSettlement(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+
+	cases := []struct {
+		name    string
+		options *ListChargebacksOptions
+		query   string
+		wantErr bool
+		err     error
+		handler http.HandlerFunc
+	}{
+		{
+			"list settlement chargebacks works as expected",
+			&ListChargebacksOptions{From: "chb_xvb2kq", Limit: 1},
+			"from=chb_xvb2kq&limit=1",
+			false,
+			nil,
+			nil,
+		},
+		{
+			"list settlement chargebacks returns an http error from the remote server",
+			nil,
+			"",
+			true,
+			fmt.Errorf("500 Internal Server Error: An internal server error occurred while processing your request."),
+			errorHandler,
+		},
+	}
+
+	for _, c := range cases {
+		setup()
+		defer teardown()
+
+		t.Run(c.name, func(t *testing.T) {
+			handler := c.handler
+			if handler == nil {
+				handler = func(w http.ResponseWriter, r *http.Request) {
+					testHeader(t, r, AuthHeader, "Bearer token_X12b31ggg23")
+					testMethod(t, r, "GET")
+					testQuery(t, r, c.query)
+					_, _ = w.Write([]byte(testdata.ListChargebacksLastPageResponse))
+				}
+			}
+			tMux.HandleFunc("/v2/settlements/stl_jDk30akdN/chargebacks", handler)
+
+			res, cl, err := tClient.Chargebacks.ListForSettlement(context.Background(), "stl_jDk30akdN", c.options)
+			if c.wantErr {
+				assert.EqualError(t, err, c.err.Error())
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.IsType(t, &http.Response{}, res.Response)
+			assert.NotEmpty(t, cl.Embedded.Chargebacks)
+		})
+	}
+}
+
+func TestChargebacksService_ListBetween(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+
+	cases := []struct {
+		name  string
+		from  time.Time
+		to    time.Time
+		want  []string
+		pages []string
+		err   error
+	}{
+		{
+			"chargebacks newer than the window are skipped",
+			time.Date(2018, 3, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2018, 3, 13, 0, 0, 0, 0, time.UTC),
+			[]string{"chb_xvb2kq"},
+			[]string{"", "chb_xvb2kq"},
+			nil,
+		},
+		{
+			"pages older than the window are not requested",
+			time.Date(2018, 3, 13, 0, 0, 0, 0, time.UTC),
+			time.Date(2018, 3, 31, 0, 0, 0, 0, time.UTC),
+			[]string{"chb_n9z0tp"},
+			[]string{"", "chb_xvb2kq"},
+			nil,
+		},
+		{
+			"windows without chargebacks return nothing",
+			time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2019, 12, 31, 0, 0, 0, 0, time.UTC),
+			nil,
+			[]string{""},
+			nil,
+		},
+	}
+
+	for _, c := range cases {
+		setup()
+		defer teardown()
+
+		t.Run(c.name, func(t *testing.T) {
+			var pages []string
+
+			tMux.HandleFunc("/v2/chargebacks", func(w http.ResponseWriter, r *http.Request) {
+				from := r.URL.Query().Get("from")
+				pages = append(pages, from)
+
+				switch from {
+				case "":
+					_, _ = w.Write([]byte(testdata.ListChargebacksFirstPageResponse))
+				case "chb_xvb2kq":
+					_, _ = w.Write([]byte(testdata.ListChargebacksLastPageResponse))
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			})
+
+			cbs, err := tClient.Chargebacks.ListBetween(context.Background(), c.from, c.to, &ListChargebacksOptions{Limit: 1})
+			assert.Equal(t, c.err, err)
+
+			var got []string
+			for _, cb := range cbs {
+				got = append(got, cb.ID)
+			}
+
+			assert.Equal(t, c.want, got)
+			assert.Equal(t, c.pages, pages)
+		})
+	}
+}
+
+func TestChargebacksService_ListBetween_Error(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	tMux.HandleFunc("/v2/chargebacks", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("from") == "" {
+			_, _ = w.Write([]byte(testdata.ListChargebacksFirstPageResponse))
+
+			return
+		}
+
+		errorHandler(w, r)
+	})
+
+	from := time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2018, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	cbs, err := tClient.Chargebacks.ListBetween(context.Background(), from, to, &ListChargebacksOptions{Limit: 1})
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrPaginationTruncated)
+	require.Len(t, cbs, 1)
+	assert.Equal(t, "chb_n9z0tp", cbs[0].ID)
+}
+
+func TestChargebacksService_ListUntil_IgnoresSort(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	tMux.HandleFunc("/v2/chargebacks", func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.URL.Query().Get("sort"))
+
+		switch r.URL.Query().Get("from") {
+		case "":
+			_, _ = w.Write([]byte(testdata.ListChargebacksFirstPageResponse))
+		default:
+			_, _ = w.Write([]byte(testdata.ListChargebacksLastPageResponse))
+		}
+	})
+
+	opts := &ListChargebacksOptions{Limit: 1, Sort: SortAsc}
+	cutoff := time.Date(2018, 3, 13, 0, 0, 0, 0, time.UTC)
+
+	cbs, err := tClient.Chargebacks.ListUntil(context.Background(), opts, cutoff)
+	assert.ErrorIs(t, err, ErrPaginationTruncated)
+	require.Len(t, cbs, 1)
+	assert.Equal(t, "chb_n9z0tp", cbs[0].ID)
+
+	window, err := tClient.Chargebacks.ListBetween(context.Background(), cutoff, time.Now(), opts)
+	assert.NoError(t, err)
+	assert.Equal(t, cbs, window)
+	assert.Equal(t, SortAsc, opts.Sort)
+}
+
+func TestChargebacksService_ListBetween_WithoutCreationDate(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	tMux.HandleFunc("/v2/chargebacks", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{
+			"count": 2,
+			"_embedded": {
+				"chargebacks": [
+					{"resource": "chargeback", "id": "chb_n9z0tp"},
+					{"resource": "chargeback", "id": "chb_xvb2kq", "createdAt": "2018-03-14T17:00:52.0Z"}
+				]
+			},
+			"_links": {"next": null}
+		}`))
+	})
+
+	from := time.Date(2018, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2018, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	cbs, err := tClient.Chargebacks.ListBetween(context.Background(), from, to, nil)
+	require.NoError(t, err)
+	require.Len(t, cbs, 2)
+	assert.Nil(t, cbs[0].CreatedAt)
+
+	until, err := tClient.Chargebacks.ListUntil(context.Background(), nil, from)
+	require.NoError(t, err)
+	assert.Equal(t, until, cbs)
+}
+
+func TestListChargebacksOptions_Sort(t *testing.T) {
+	cases := []struct {
+		name    string
+		options any
+		want    string
+	}{
+		{"chargebacks in ascending order", &ListChargebacksOptions{Sort: SortAsc, Limit: 5}, "limit=5&sort=asc"},
+		{"payments in descending order", &ListPaymentsOptions{Sort: SortDesc}, "sort=desc"},
+		{"refunds in ascending order", &ListRefundsOptions{Sort: SortAsc}, "sort=asc"},
+		{"orders in ascending order", &ListOrdersOptions{Sort: string(SortAsc)}, "sort=asc"},
+		{"the sort direction is omitted by default", &ListChargebacksOptions{Limit: 5}, "limit=5"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			v, err := query.Values(c.options)
+			require.NoError(t, err)
+			assert.Equal(t, c.want, v.Encode())
+		})
+	}
+}
+
+func TestChargeback_Reversed(t *testing.T) {
+	var cb Chargeback
+	require.NoError(t, json.Unmarshal([]byte(testdata.GetChargebackResponse), &cb))
+	assert.False(t, cb.Reversed())
+
+	reversedAt := time.Date(2018, 3, 15, 10, 0, 0, 0, time.UTC)
+	cb.ReversedAt = &reversedAt
+	assert.True(t, cb.Reversed())
+
+	var nilCb *Chargeback
+	assert.False(t, nilCb.Reversed())
+}
+
+func TestChargeback_Age(t *testing.T) {
+	var cb Chargeback
+	require.NoError(t, json.Unmarshal([]byte(testdata.GetChargebackResponse), &cb))
+
+	age, ok := cb.Age()
+	assert.True(t, ok)
+	assert.True(t, age > 0)
+
+	createdAt := time.Now().Add(-2 * time.Hour)
+	cb.CreatedAt = &createdAt
+
+	age, ok = cb.Age()
+	assert.True(t, ok)
+	assert.InDelta(t, 2*time.Hour, age, float64(time.Minute))
+
+	reversedAt := createdAt.Add(time.Hour)
+	cb.ReversedAt = &reversedAt
+
+	age, ok = cb.Age()
+	assert.True(t, ok)
+	assert.InDelta(t, 2*time.Hour, age, float64(time.Minute))
+
+	cb.CreatedAt = nil
+
+	age, ok = cb.Age()
+	assert.False(t, ok)
+	assert.Zero(t, age)
+
+	var nilCb *Chargeback
+
+	_, ok = nilCb.Age()
+	assert.False(t, ok)
+}
+
+func TestChargeback_ReversalDuration(t *testing.T) {
+	createdAt := time.Date(2018, 3, 14, 17, 0, 0, 0, time.UTC)
+	reversedAt := createdAt.Add(36 * time.Hour)
+
+	cb := &Chargeback{CreatedAt: &createdAt}
+
+	_, ok := cb.ReversalDuration()
+	assert.False(t, ok)
+	assert.False(t, cb.IsReversed())
+
+	cb.ReversedAt = &reversedAt
+
+	d, ok := cb.ReversalDuration()
+	assert.True(t, ok)
+	assert.Equal(t, 36*time.Hour, d)
+	assert.True(t, cb.IsReversed())
+
+	cb.CreatedAt = nil
+
+	_, ok = cb.ReversalDuration()
+	assert.False(t, ok)
+
+	var nilCb *Chargeback
+
+	_, ok = nilCb.ReversalDuration()
+	assert.False(t, ok)
+	assert.False(t, nilCb.IsReversed())
+}
+
+func TestChargebacksService_Create(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+
+	cases := []struct {
+		name    string
+		auth    string
+		testing bool
+		options *ChargebackOptions
+		query   string
+		err     error
+	}{
+		{
+			"chargebacks are created with test api keys",
+			"test_dHar4XY7LxsDOtmnkVtjNVWXLSlXsM",
+			false,
+			nil,
+			"",
+			nil,
+		},
+		{
+			"chargebacks are created with access tokens in testing mode",
+			"access_Wwvu7egPcJLLJ9Kb7J632x8wJ2zMeJ",
+			true,
+			nil,
+			"testmode=true",
+			nil,
+		},
+		{
+			"chargebacks are created with access tokens and the testmode option",
+			"access_Wwvu7egPcJLLJ9Kb7J632x8wJ2zMeJ",
+			false,
+			&ChargebackOptions{Testmode: true},
+			"testmode=true",
+			nil,
+		},
+		{
+			"chargebacks are not created with live api keys",
+			"live_dHar4XY7LxsDOtmnkVtjNVWXLSlXsM",
+			true,
+			&ChargebackOptions{Testmode: true},
+			"",
+			ErrTestModeOnly,
+		},
+		{
+			"chargebacks are not created with access tokens in live mode",
+			"access_Wwvu7egPcJLLJ9Kb7J632x8wJ2zMeJ",
+			false,
+			nil,
+			"",
+			ErrTestModeOnly,
+		},
+	}
+
+	for _, c := range cases {
+		setup()
+		defer teardown()
+
+		t.Run(c.name, func(t *testing.T) {
+			var sent bool
+
+			tMux.HandleFunc("/v2/payments/tr_WDqYK6vllg/chargebacks", func(w http.ResponseWriter, r *http.Request) {
+				sent = true
+				testMethod(t, r, "POST")
+				testQuery(t, r, c.query)
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(testdata.GetChargebackResponse))
+			})
+
+			require.NoError(t, tClient.WithAuthenticationValue(c.auth))
+			tClient.config.testing = c.testing
+
+			_, cb, err := tClient.Chargebacks.Create(context.Background(), "tr_WDqYK6vllg", c.options)
+			if c.err != nil {
+				assert.ErrorIs(t, err, c.err)
+				assert.False(t, sent)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "chb_n9z0tp", cb.ID)
+		})
+	}
+}
+
+func TestChargebacksService_Payment(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+
+	cases := []struct {
+		name string
+		cb   *Chargeback
+		err  error
+	}{
+		{
+			"the payment is retrieved using the payment id",
+			&Chargeback{PaymentID: "tr_WDqYK6vllg"},
+			nil,
+		},
+		{
+			"the payment is retrieved using the payment link",
+			&Chargeback{Links: ChargebackLinks{Payment: &URL{Href: "https://api.mollie.com/v2/payments/tr_WDqYK6vllg"}}},
+			nil,
+		},
+		{
+			"chargebacks without payment reference are rejected",
+			&Chargeback{ID: "chb_n9z0tp"},
+			ErrChargebackWithoutPayment,
+		},
+		{
+			"nil chargebacks are rejected",
+			nil,
+			ErrChargebackWithoutPayment,
+		},
+	}
+
+	for _, c := range cases {
+		setup()
+		defer teardown()
+
+		t.Run(c.name, func(t *testing.T) {
+			tMux.HandleFunc("/v2/payments/tr_WDqYK6vllg", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "GET")
+				_, _ = w.Write([]byte(testdata.GetPaymentResponse))
+			})
+
+			_, p, err := tClient.Chargebacks.Payment(context.Background(), c.cb)
+			if c.err != nil {
+				assert.ErrorIs(t, err, c.err)
+				assert.Nil(t, p)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "tr_WDqYK6vllg", p.ID)
+		})
+	}
+}
+
+func TestChargebacksService_ListGroupedByProfile(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	var lookups int32
+
+	tMux.HandleFunc("/v2/chargebacks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = w.Write([]byte(testdata.ListChargebacksAcrossProfilesResponse))
+	})
+	tMux.HandleFunc("/v2/payments/tr_WDqYK6vllg", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&lookups, 1)
+		_, _ = w.Write([]byte(testdata.GetPaymentResponse))
+	})
+	tMux.HandleFunc("/v2/payments/tr_7UhSN1zuXS", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&lookups, 1)
+		_, _ = w.Write([]byte(testdata.GetPaymentOtherProfileResponse))
+	})
+
+	grouped, err := tClient.Chargebacks.ListGroupedByProfile(context.Background(), nil)
+	require.Nil(t, err)
+
+	assert.Equal(t, int32(2), atomic.LoadInt32(&lookups))
+	require.Len(t, grouped, 2)
+	require.Len(t, grouped["pfl_QkEhN94Ba"], 2)
+	assert.Equal(t, "chb_n9z0tp", grouped["pfl_QkEhN94Ba"][0].ID)
+	assert.Equal(t, "chb_ls7ahg", grouped["pfl_QkEhN94Ba"][1].ID)
+	require.Len(t, grouped["pfl_v9hTwCvYqw"], 1)
+	assert.Equal(t, "chb_xvb2kq", grouped["pfl_v9hTwCvYqw"][0].ID)
+}
+
+func TestChargebacksService_ListGroupedByProfile_Forbidden(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	tMux.HandleFunc("/v2/chargebacks", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(testdata.ListChargebacksAcrossProfilesResponse))
+	})
+	tMux.HandleFunc("/v2/payments/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(testdata.ForbiddenErrorResponse))
+	})
+
+	grouped, err := tClient.Chargebacks.ListGroupedByProfile(context.Background(), nil)
+	assert.Nil(t, grouped)
+	assert.ErrorIs(t, err, ErrProfileUnresolved)
+	assert.ErrorIs(t, err, ErrForbidden)
+}
+
+func TestChargebacksService_List_SettlementID(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	tMux.HandleFunc("/v2/settlements/stl_jDk30akdN/chargebacks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, "limit=10")
+		_, _ = w.Write([]byte(testdata.ListSettlementChargebacksResponse))
+	})
+	tMux.HandleFunc("/v2/chargebacks", func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("settlement chargebacks must not be listed from the organization")
+	})
+
+	opts := &ListChargebacksOptions{SettlementID: "stl_jDk30akdN", Limit: 10}
+
+	_, cl, err := tClient.Chargebacks.List(context.Background(), opts)
+	require.Nil(t, err)
+	assert.NotEmpty(t, cl.Embedded.Chargebacks)
+
+	_, err = tClient.Chargebacks.ListAll(opts).Next(context.Background())
+	require.Nil(t, err)
+
+	opts.ProfileID = "pfl_QkEhN94Ba"
+	tClient.WithProfileScopeValidation(false)
+
+	_, _, err = tClient.Chargebacks.List(context.Background(), opts)
+
+	var ve *ValidationError
+	require.ErrorAs(t, err, &ve)
+	assert.Equal(t, "settlementId", ve.Field)
+
+	_, err = tClient.Chargebacks.ListAll(opts).Next(context.Background())
+	assert.ErrorAs(t, err, &ve)
+}
+
+type flushRecorder struct {
+	strings.Builder
+	flushes []int
+	onWrite func()
+}
+
+func (f *flushRecorder) Write(p []byte) (int, error) {
+	if f.onWrite != nil {
+		f.onWrite()
+	}
+
+	return f.Builder.Write(p)
+}
+
+func (f *flushRecorder) Flush() error {
+	f.flushes = append(f.flushes, strings.Count(f.String(), "\n"))
+
+	return nil
+}
+
+func TestChargebacksService_Export(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	tMux.HandleFunc("/v2/chargebacks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		switch r.URL.Query().Get("from") {
+		case "":
+			_, _ = w.Write([]byte(testdata.ListChargebacksFirstPageResponse))
+		case "chb_xvb2kq":
+			_, _ = w.Write([]byte(testdata.ListChargebacksLastPageResponse))
+		default:
+			t.Fatalf("unexpected page %s", r.URL.RawQuery)
+		}
+	})
+
+	var out flushRecorder
+
+	n, err := tClient.Chargebacks.Export(context.Background(), &ListChargebacksOptions{Limit: 1}, &out)
+	require.Nil(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, []int{1, 2}, out.flushes)
+
+	lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	require.Len(t, lines, 2)
+
+	var cb Chargeback
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &cb))
+	assert.Equal(t, "chb_xvb2kq", cb.ID)
+	assert.Equal(t, "tr_7UhSN1zuXS", cb.PaymentID)
+}
+
+func TestChargebacksService_Export_ContextCancellation(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	tMux.HandleFunc("/v2/chargebacks", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(testdata.ListChargebacksAcrossProfilesResponse))
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	out := flushRecorder{onWrite: cancel}
+
+	n, err := tClient.Chargebacks.Export(ctx, nil, &out)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, 1, strings.Count(out.String(), "\n"))
+}
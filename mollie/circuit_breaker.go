@@ -0,0 +1,111 @@
+package mollie
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned without sending the request while the circuit
+// breaker is open, see Client.WithCircuitBreaker.
+var ErrCircuitOpen = errors.New("circuit open: requests are paused after consecutive failures")
+
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+// circuitBreaker stops sending requests after consecutive failures.
+type circuitBreaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	failures  int
+	state     circuitState
+	openedAt  time.Time
+	probing   bool
+}
+
+// WithCircuitBreaker enables a circuit breaker that opens after the given
+// number of consecutive failures, transport errors and 5xx responses.
+//
+// While open, requests fail immediately with ErrCircuitOpen. Once the cooldown
+// elapses a single request is let through as a probe, its success closes the
+// circuit and its failure opens it for another cooldown. Retries are subject to
+// the circuit breaker as well. Non positive values disable the circuit breaker.
+func (c *Client) WithCircuitBreaker(failures int, cooldown time.Duration) {
+	if failures <= 0 || cooldown <= 0 {
+		c.breaker = nil
+
+		return
+	}
+
+	c.breaker = &circuitBreaker{
+		threshold: failures,
+		cooldown:  cooldown,
+	}
+}
+
+// allow reports whether a request can be sent.
+func (cb *circuitBreaker) allow() bool {
+	if cb == nil {
+		return true
+	}
+
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	switch cb.state {
+	case circuitOpen:
+		if time.Since(cb.openedAt) < cb.cooldown {
+			return false
+		}
+
+		cb.state = circuitHalfOpen
+		cb.probing = true
+
+		return true
+	case circuitHalfOpen:
+		if cb.probing {
+			return false
+		}
+
+		cb.probing = true
+
+		return true
+	default:
+		return true
+	}
+}
+
+// record updates the circuit with the outcome of a sent request.
+func (cb *circuitBreaker) record(req *http.Request, res *Response, err error) {
+	if cb == nil {
+		return
+	}
+
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	cb.probing = false
+
+	switch {
+	case err != nil && req.Context().Err() != nil:
+		// Requests aborted by the caller say nothing about Mollie's health.
+		return
+	case res == nil || res.Response == nil || res.StatusCode >= http.StatusInternalServerError:
+		cb.failures++
+
+		if cb.state == circuitHalfOpen || cb.failures >= cb.threshold {
+			cb.state = circuitOpen
+			cb.openedAt = time.Now()
+		}
+	default:
+		cb.failures = 0
+		cb.state = circuitClosed
+	}
+}
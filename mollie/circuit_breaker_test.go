@@ -0,0 +1,121 @@
+package mollie
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/VictorAvelar/mollie-api-go/v4/testdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_WithCircuitBreaker(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	var (
+		calls  int32
+		status int32 = http.StatusInternalServerError
+	)
+
+	tMux.HandleFunc("/v2/payments/tr_WDqYK6vllg", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+
+		if s := int(atomic.LoadInt32(&status)); s != http.StatusOK {
+			errorHandler(w, r)
+
+			return
+		}
+
+		_, _ = w.Write([]byte(testdata.GetPaymentResponse))
+	})
+
+	tClient.WithCircuitBreaker(2, 50*time.Millisecond)
+
+	get := func() error {
+		_, _, err := tClient.Payments.Get(context.Background(), "tr_WDqYK6vllg", nil)
+
+		return err
+	}
+
+	require.Error(t, get())
+	require.Error(t, get())
+	assert.ErrorIs(t, get(), ErrCircuitOpen)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			assert.ErrorIs(t, get(), ErrCircuitOpen)
+		}()
+	}
+
+	wg.Wait()
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+
+	time.Sleep(60 * time.Millisecond)
+
+	// The failed probe opens the circuit for another cooldown.
+	require.Error(t, get())
+	assert.ErrorIs(t, get(), ErrCircuitOpen)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+
+	time.Sleep(60 * time.Millisecond)
+	atomic.StoreInt32(&status, http.StatusOK)
+
+	// The successful probe closes the circuit.
+	require.NoError(t, get())
+	require.NoError(t, get())
+	assert.Equal(t, int32(5), atomic.LoadInt32(&calls))
+}
+
+func TestClient_WithCircuitBreaker_ClientErrors(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	var calls int
+
+	tMux.HandleFunc("/v2/payments/tr_WDqYK6vllg", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(testdata.NotFoundErrorResponse))
+	})
+
+	tClient.WithCircuitBreaker(1, time.Minute)
+
+	for i := 0; i < 3; i++ {
+		_, _, err := tClient.Payments.Get(context.Background(), "tr_WDqYK6vllg", nil)
+		assert.NotErrorIs(t, err, ErrCircuitOpen)
+	}
+
+	assert.Equal(t, 3, calls)
+}
+
+func TestClient_WithCircuitBreaker_Disabled(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	tMux.HandleFunc("/v2/payments/tr_WDqYK6vllg", errorHandler)
+
+	tClient.WithCircuitBreaker(1, time.Minute)
+	tClient.WithCircuitBreaker(0, 0)
+
+	for i := 0; i < 3; i++ {
+		_, _, err := tClient.Payments.Get(context.Background(), "tr_WDqYK6vllg", nil)
+		assert.NotErrorIs(t, err, ErrCircuitOpen)
+	}
+}
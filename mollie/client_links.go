@@ -2,7 +2,6 @@ package mollie
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/google/go-querystring/query"
@@ -39,17 +38,17 @@ type ClientLinksService service
 // Create a client link based on the provided CreateClientLink values.
 //
 // See: https://docs.mollie.com/reference/v2/client-links-api/create-client-link
-func (cls *ClientLinksService) Create(ctx context.Context, cd CreateClientLink) (
+func (cls *ClientLinksService) Create(ctx context.Context, cd CreateClientLink, reqOpts ...RequestOption) (
 	res *Response,
 	cl *ClientLink,
 	err error,
 ) {
-	res, err = cls.client.post(ctx, "v2/client-links", cd, nil)
+	res, err = cls.client.post(ctx, "v2/client-links", cd, nil, reqOpts...)
 	if err != nil {
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &cl); err != nil {
+	if err = res.decode(&cl); err != nil {
 		return
 	}
 
@@ -64,7 +64,7 @@ func TestClientLinkService_Create(t *testing.T) {
 				CreateClientLink{},
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding ClientLink: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			encodingHandler,
 			noPre,
 		},
@@ -130,7 +130,7 @@ func TestClientLinkService_GetFinalClientLink(t *testing.T) {
 					Scope:    []PermissionGrant{OnboardingRead, OnboardingWrite},
 				},
 			},
-			"https://my.mollie.com/dashboard/client-link/finalize/csr_vZCnNQsV2UtfXxYifWKWH?clientId=app_j9Pakf56Ajta6Y65AkdTtAv&scope=onboarding.read%2Bonbording.write&state=unique_string_to_compare",
+			"https://my.mollie.com/dashboard/client-link/finalize/csr_vZCnNQsV2UtfXxYifWKWH?clientId=app_j9Pakf56Ajta6Y65AkdTtAv&scope=onboarding.read%2Bonboarding.write&state=unique_string_to_compare",
 		},
 		{
 			"constructs client link finalize with complex values",
@@ -2,17 +2,32 @@ package mollie
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"time"
 )
 
 // LinkedClient describes a single client, linked to your partner account.
+//
+// The organization and onboarding status of the client are only embedded
+// when requested using EmbedOrganization and EmbedOnboarding, otherwise
+// they are nil.
 type LinkedClient struct {
-	Resource              string            `json:"resource,omitempty"`
-	ID                    string            `json:"id,omitempty"`
-	OrganizationCreatedAt *time.Time        `json:"organizationCreatedAt,omitempty"`
-	Links                 LinkedClientLinks `json:"_links,omitempty"`
+	Resource              string                  `json:"resource,omitempty"`
+	ID                    string                  `json:"id,omitempty"`
+	OrganizationCreatedAt *time.Time              `json:"organizationCreatedAt,omitempty"`
+	Commission            *LinkedClientCommission `json:"commission,omitempty"`
+	Embedded              struct {
+		Organization *Organization `json:"organization,omitempty"`
+		Onboarding   *Onboarding   `json:"onboarding,omitempty"`
+	} `json:"_embedded,omitempty"`
+	Links LinkedClientLinks `json:"_links,omitempty"`
+}
+
+// LinkedClientCommission describes the commissions earned on the payments
+// of a client.
+type LinkedClientCommission struct {
+	Count       int     `json:"count,omitempty"`
+	TotalAmount *Amount `json:"totalAmount,omitempty"`
 }
 
 // LinkedClientLinks contains URL objects relevant to the client.
@@ -25,7 +40,7 @@ type LinkedClientLinks struct {
 
 // GetLinkedClientOptions contains valid query parameters for the get clients endpoint.
 type GetLinkedClientOptions struct {
-	Embed []EmbedValue `url:"embed,omitempty"`
+	Embed []EmbedValue `url:"embed,omitempty,comma"`
 }
 
 // LinkedClientList describes a list of partner clients.
@@ -41,7 +56,7 @@ type LinkedClientList struct {
 type ListLinkedClientsOptions struct {
 	Limit int          `url:"limit,omitempty"`
 	From  string       `url:"from,omitempty"`
-	Embed []EmbedValue `url:"embed,omitempty"`
+	Embed []EmbedValue `url:"embed,omitempty,comma"`
 }
 
 // ClientsService operates over the partners API.
@@ -60,7 +75,7 @@ func (ps *ClientsService) List(ctx context.Context, opts *ListLinkedClientsOptio
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &pc); err != nil {
+	if err = res.decode(&pc); err != nil {
 		return
 	}
 
@@ -80,7 +95,7 @@ func (ps *ClientsService) Get(ctx context.Context, id string, opts *GetLinkedCli
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &pc); err != nil {
+	if err = res.decode(&pc); err != nil {
 		return
 	}
 
@@ -8,6 +8,7 @@ import (
 
 	"github.com/VictorAvelar/mollie-api-go/v4/testdata"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestClientsService_Get(t *testing.T) {
@@ -91,7 +92,7 @@ func TestClientsService_Get(t *testing.T) {
 				nil,
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding LinkedClient: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -211,7 +212,7 @@ func TestClientService_List(t *testing.T) {
 				nil,
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding LinkedClientList: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -249,3 +250,31 @@ func TestClientService_List(t *testing.T) {
 		})
 	}
 }
+
+func TestClientsService_Get_Embedded(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	tMux.HandleFunc("/v2/clients/org_1337", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, "embed=organization%2Conboarding")
+		_, _ = w.Write([]byte(testdata.GetPartnerClientEmbeddedResponse))
+	})
+
+	_, c, err := tClient.Clients.Get(context.Background(), "org_1337", &GetLinkedClientOptions{
+		Embed: []EmbedValue{EmbedOrganization, EmbedOnboarding},
+	})
+	require.Nil(t, err)
+
+	require.NotNil(t, c.Commission)
+	assert.Equal(t, 200, c.Commission.Count)
+	assert.Equal(t, "10.00", c.Commission.TotalAmount.Value)
+
+	require.NotNil(t, c.Embedded.Organization)
+	assert.Equal(t, "Mollie B.V.", c.Embedded.Organization.Name)
+	require.NotNil(t, c.Embedded.Onboarding)
+	assert.Equal(t, CompletedOnboardingStatus, c.Embedded.Onboarding.Status)
+	assert.Equal(t, "org_1337", c.Links.Onboarding.ResourceID())
+}
@@ -0,0 +1,73 @@
+package mollie
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrClientClosed is returned without sending the request once the client
+// has been closed, see Client.Close.
+var ErrClientClosed = errors.New("client closed: requests can not be sent after close")
+
+// lifecycle tracks whether the client has been closed.
+type lifecycle struct {
+	once   sync.Once
+	closed chan struct{}
+}
+
+// Close releases the resources held by the client.
+//
+// Requests in flight, including the ones started by GetMany and retries
+// waiting for their backoff, are cancelled and later requests fail with
+// ErrClientClosed. Idle connections of the underlying http.Client are closed.
+// Close is safe to call multiple times and from multiple goroutines.
+func (c *Client) Close() error {
+	c.lifecycle.once.Do(func() {
+		if c.lifecycle.closed == nil {
+			c.lifecycle.closed = make(chan struct{})
+		}
+
+		close(c.lifecycle.closed)
+
+		if c.client != nil {
+			c.client.CloseIdleConnections()
+		}
+	})
+
+	return nil
+}
+
+// isClosed reports whether Close has been called.
+func (c *Client) isClosed() bool {
+	if c.lifecycle.closed == nil {
+		return false
+	}
+
+	select {
+	case <-c.lifecycle.closed:
+		return true
+	default:
+		return false
+	}
+}
+
+// bind returns a context cancelled either when ctx is done or when the
+// client is closed.
+func (c *Client) bind(ctx context.Context) (context.Context, context.CancelFunc) {
+	if c.lifecycle.closed == nil {
+		return ctx, func() {}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	go func() {
+		select {
+		case <-c.lifecycle.closed:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	return ctx, cancel
+}
@@ -0,0 +1,91 @@
+package mollie
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/VictorAvelar/mollie-api-go/v4/testdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Close(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	var calls int32
+
+	tMux.HandleFunc("/v2/payments/tr_WDqYK6vllg", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		_, _ = w.Write([]byte(testdata.GetPaymentResponse))
+	})
+
+	_, _, err := tClient.Payments.Get(context.Background(), "tr_WDqYK6vllg", nil)
+	require.Nil(t, err)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			assert.Nil(t, tClient.Close())
+		}()
+	}
+
+	wg.Wait()
+
+	_, _, err = tClient.Payments.Get(context.Background(), "tr_WDqYK6vllg", nil)
+	assert.ErrorIs(t, err, ErrClientClosed)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestClient_Close_CancelsInFlightRequests(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	started := make(chan struct{})
+
+	tMux.HandleFunc("/v2/payments/tr_WDqYK6vllg", func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	})
+
+	errs := make(chan error, 1)
+
+	go func() {
+		_, _, err := tClient.Payments.Get(context.Background(), "tr_WDqYK6vllg", nil)
+		errs <- err
+	}()
+
+	<-started
+	require.Nil(t, tClient.Close())
+
+	select {
+	case err := <-errs:
+		assert.ErrorIs(t, err, context.Canceled)
+	case <-time.After(time.Second):
+		t.Fatal("request was not cancelled by close")
+	}
+}
+
+func TestClient_Close_ZeroValue(t *testing.T) {
+	c := &Client{}
+
+	assert.Nil(t, c.Close())
+	assert.Nil(t, c.Close())
+	assert.True(t, c.isClosed())
+}
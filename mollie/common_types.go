@@ -3,6 +3,7 @@ package mollie
 import (
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"strings"
 	"time"
 )
@@ -13,7 +14,8 @@ type Amount struct {
 	Value    string `json:"value,omitempty"    url:"value,omitempty"`
 }
 
-// Address provides a human friendly representation of a geographical space.
+// Address provides a human friendly representation of a geographical space and,
+// for orders, the person it belongs to.
 //
 // When providing an address object as parameter to a request, the following conditions must be met:
 //
@@ -21,14 +23,68 @@ type Amount struct {
 // If only the region field is given, one should provide all the other fields as per the previous condition.
 // For certain PayPal payments the region field is required.
 type Address struct {
-	GivenName        string `json:"givenName,omitempty"`
-	FamilyName       string `json:"familyName,omitempty"`
-	StreetAndNumber  string `json:"streetAndNumber,omitempty"`
-	StreetAdditional string `json:"streetAdditional,omitempty"`
-	PostalCode       string `json:"postalCode,omitempty"`
-	City             string `json:"city,omitempty"`
-	Region           string `json:"region,omitempty"`
-	Country          string `json:"country,omitempty"`
+	OrganizationName string      `json:"organizationName,omitempty"`
+	Title            string      `json:"title,omitempty"`
+	GivenName        string      `json:"givenName,omitempty"`
+	FamilyName       string      `json:"familyName,omitempty"`
+	Email            string      `json:"email,omitempty"`
+	StreetAndNumber  string      `json:"streetAndNumber,omitempty"`
+	StreetAdditional string      `json:"streetAdditional,omitempty"`
+	PostalCode       string      `json:"postalCode,omitempty"`
+	City             string      `json:"city,omitempty"`
+	Region           string      `json:"region,omitempty"`
+	Country          string      `json:"country,omitempty"`
+	Phone            PhoneNumber `json:"phone,omitempty"`
+}
+
+var countryExpr = regexp.MustCompile(`^[A-Z]{2}$`)
+
+// Validate checks the address against the rules enforced by Mollie: the
+// country must be an ISO 3166-1 alpha-2 code and, once any location field is
+// provided, the street and number, city and country are required. The postal
+// code is not required as some countries do not use them.
+func (a *Address) Validate() error {
+	return validateAddress("", a)
+}
+
+// validateAddress validates a, reporting errors for the fields nested under
+// field, nil addresses are omitted from the request.
+func validateAddress(field string, a *Address) error {
+	if a == nil {
+		return nil
+	}
+
+	name := func(f string) string {
+		if field == "" {
+			return f
+		}
+
+		return field + "." + f
+	}
+
+	if a.Country != "" && !countryExpr.MatchString(a.Country) {
+		return &ValidationError{
+			Field:  name("country"),
+			Reason: fmt.Sprintf("%q is not a valid ISO 3166-1 alpha-2 country code", a.Country),
+		}
+	}
+
+	if a.StreetAndNumber == "" && a.StreetAdditional == "" && a.PostalCode == "" &&
+		a.City == "" && a.Region == "" && a.Country == "" {
+		return nil
+	}
+
+	for _, f := range []struct{ name, value string }{
+		{"streetAndNumber", a.StreetAndNumber},
+		{"city", a.City},
+		{"country", a.Country},
+	} {
+		if f.value == "" {
+			return &ValidationError{Field: name(f.name), Reason: "is required"}
+		}
+	}
+
+	return nil
 }
 
 // EntityType for an organization.
@@ -114,6 +170,34 @@ const (
 	Lithuanian    Locale = "lt_LT"
 )
 
+var localeExpr = regexp.MustCompile(`^[a-z]{2}_[A-Z]{2}$`)
+
+// Valid reports whether the locale is one of the locales supported by Mollie.
+func (l Locale) Valid() bool {
+	switch l {
+	case English, EnglishGB, Dutch, DutchBelgium, French, FrenchBelgium, German, GermanAustria,
+		GermanSwiss, Spanish, Catalan, Portuguese, Italian, Norwegian, Swedish, Finish, Danish,
+		Icelandic, Hungarian, Polish, Latvian, Lithuanian:
+		return true
+	default:
+		return false
+	}
+}
+
+// validateLocale rejects malformed locales before sending the request, well
+// formed locales without a constant are accepted so locales supported by
+// Mollie in the future can be used right away, empty locales are omitted.
+func validateLocale(l Locale) error {
+	if l == "" || l.Valid() || localeExpr.MatchString(string(l)) {
+		return nil
+	}
+
+	return &ValidationError{
+		Field:  "locale",
+		Reason: fmt.Sprintf("%q is not a valid locale, expected a language and country code like en_US", string(l)),
+	}
+}
+
 // PhoneNumber represents a phone number in the E.164 format.
 type PhoneNumber string
 
@@ -321,6 +405,16 @@ const (
 	TestMode Mode = "test"
 )
 
+// SortDirection describes the order in which list endpoints return
+// their results, Mollie returns the newest resources first by default.
+type SortDirection string
+
+// Valid sort directions.
+const (
+	SortAsc  SortDirection = "asc"
+	SortDesc SortDirection = "desc"
+)
+
 // IncludeValue is a valid value for the Include query string parameter.
 type IncludeValue string
 
@@ -330,6 +424,7 @@ const (
 	IncludeRemainderDetails IncludeValue = "details.remainderDetails"
 	IncludeIssuers          IncludeValue = "issuers"
 	IncludePricing          IncludeValue = "pricing"
+	IncludeSettlements      IncludeValue = "settlements"
 )
 
 // EmbedValue describes the valid value of embed query string.
@@ -337,6 +432,7 @@ type EmbedValue string
 
 // Valid Embed query string value.
 const (
+	EmbedPayment      EmbedValue = "payment"
 	EmbedPayments     EmbedValue = "payments"
 	EmbedRefunds      EmbedValue = "refunds"
 	EmbedShipments    EmbedValue = "shipments"
@@ -346,6 +442,46 @@ const (
 	EmbedOnboarding   EmbedValue = "onboarding"
 )
 
+// IncludeValues is a list of include values, it can be assigned to the
+// Include field of any options struct, which sends them as a single comma
+// separated parameter.
+type IncludeValues []IncludeValue
+
+// Includes builds a list of include values.
+func Includes(values ...IncludeValue) IncludeValues {
+	return values
+}
+
+// String returns the values in the comma separated form expected by Mollie.
+func (iv IncludeValues) String() string {
+	s := make([]string, len(iv))
+	for i, v := range iv {
+		s[i] = string(v)
+	}
+
+	return strings.Join(s, ",")
+}
+
+// EmbedValues is a list of embed values, it can be assigned to the
+// Embed field of any options struct, which sends them as a single comma
+// separated parameter.
+type EmbedValues []EmbedValue
+
+// Embeds builds a list of embed values, e.g. Embeds(EmbedPayment, EmbedRefunds).
+func Embeds(values ...EmbedValue) EmbedValues {
+	return values
+}
+
+// String returns the values in the comma separated form expected by Mollie.
+func (ev EmbedValues) String() string {
+	s := make([]string, len(ev))
+	for i, v := range ev {
+		s[i] = string(v)
+	}
+
+	return strings.Join(s, ",")
+}
+
 // Rate describes service rates, further divided into fixed and percentage costs.
 type Rate struct {
 	Variable string  `json:"variable,omitempty"`
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/go-querystring/query"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -49,3 +50,102 @@ func TestShortDate_MarshalJSON(t *testing.T) {
 		assert.Nil(t, err)
 	})
 }
+
+func TestEmbeds(t *testing.T) {
+	assert.Equal(t, "payment,refunds", Embeds(EmbedPayment, EmbedRefunds).String())
+	assert.Equal(t, "", Embeds().String())
+
+	v, err := query.Values(&ChargebackOptions{Embed: Embeds(EmbedPayment)})
+	assert.Nil(t, err)
+	assert.Equal(t, "embed=payment", v.Encode())
+}
+
+func TestIncludes(t *testing.T) {
+	assert.Equal(t, "details.qrCode,settlements", Includes(IncludeQrCode, IncludeSettlements).String())
+
+	v, err := query.Values(&PaymentOptions{Include: Includes(IncludeQrCode, IncludeRemainderDetails)})
+	assert.Nil(t, err)
+	assert.Equal(t, "include=details.qrCode%2Cdetails.remainderDetails", v.Encode())
+}
+
+func TestLocale_Valid(t *testing.T) {
+	assert.True(t, English.Valid())
+	assert.True(t, Lithuanian.Valid())
+	assert.False(t, Locale("xx_XX").Valid())
+	assert.False(t, Locale("").Valid())
+}
+
+func TestValidateLocale(t *testing.T) {
+	cases := []struct {
+		locale  Locale
+		wantErr bool
+	}{
+		{"", false},
+		{Dutch, false},
+		{"ro_RO", false},
+		{"en-US", true},
+		{"EN_us", true},
+		{"english", true},
+	}
+
+	for _, c := range cases {
+		t.Run(string(c.locale), func(t *testing.T) {
+			err := validateLocale(c.locale)
+			if !c.wantErr {
+				assert.Nil(t, err)
+				return
+			}
+
+			assert.IsType(t, &ValidationError{}, err)
+			assert.Contains(t, err.Error(), "validation_error: locale")
+		})
+	}
+}
+
+func TestAddress_Validate(t *testing.T) {
+	cases := []struct {
+		name    string
+		address *Address
+		field   string
+	}{
+		{"nil addresses are valid", nil, ""},
+		{"person only addresses are valid", &Address{GivenName: "Piet", Email: "piet@mondriaan.com"}, ""},
+		{
+			"complete addresses are valid",
+			&Address{StreetAndNumber: "Keizersgracht 126", PostalCode: "1015 CW", City: "Amsterdam", Country: "NL"},
+			"",
+		},
+		{
+			"addresses without a postal code are valid",
+			&Address{StreetAndNumber: "Main Street 1", City: "Dublin", Country: "IE"},
+			"",
+		},
+		{"lowercase countries are rejected", &Address{Country: "nl"}, "country"},
+		{"alpha-3 countries are rejected", &Address{Country: "NLD"}, "country"},
+		{"region only addresses are rejected", &Address{Region: "Noord-Holland"}, "streetAndNumber"},
+		{
+			"addresses without a city are rejected",
+			&Address{StreetAndNumber: "Keizersgracht 126", PostalCode: "1015 CW", Country: "NL"},
+			"city",
+		},
+		{
+			"addresses without a country are rejected",
+			&Address{StreetAndNumber: "Keizersgracht 126", City: "Amsterdam"},
+			"country",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := c.address.Validate()
+			if c.field == "" {
+				assert.Nil(t, err)
+				return
+			}
+
+			var ve *ValidationError
+			assert.ErrorAs(t, err, &ve)
+			assert.Equal(t, c.field, ve.Field)
+		})
+	}
+}
@@ -2,25 +2,34 @@ package mollie
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"time"
 )
 
 // CreateCustomer contains the parameters to create a customer.
 type CreateCustomer struct {
-	Name     string `json:"name,omitempty"`
-	Email    string `json:"email,omitempty"`
-	Locale   Locale `json:"locale,omitempty"`
-	Metadata any    `json:"metadata,omitempty"`
+	Name     string   `json:"name,omitempty"`
+	Email    string   `json:"email,omitempty"`
+	Locale   Locale   `json:"locale,omitempty"`
+	Metadata Metadata `json:"metadata,omitempty"`
+}
+
+// Validate checks the locale of the customer is well formed.
+func (cc *CreateCustomer) Validate() error {
+	return validateLocale(cc.Locale)
 }
 
 // UpdateCustomer contains the parameters to update a customer.
 type UpdateCustomer struct {
-	Name     string `json:"name,omitempty"`
-	Email    string `json:"email,omitempty"`
-	Locale   Locale `json:"locale,omitempty"`
-	Metadata any    `json:"metadata,omitempty"`
+	Name     string   `json:"name,omitempty"`
+	Email    string   `json:"email,omitempty"`
+	Locale   Locale   `json:"locale,omitempty"`
+	Metadata Metadata `json:"metadata,omitempty"`
+}
+
+// Validate checks the locale of the customer is well formed.
+func (uc *UpdateCustomer) Validate() error {
+	return validateLocale(uc.Locale)
 }
 
 // CustomerLinks contains the HAL resources for a customer response.
@@ -41,7 +50,7 @@ type Customer struct {
 	Name      string        `json:"name,omitempty"`
 	Email     string        `json:"email,omitempty"`
 	Locale    Locale        `json:"locale,omitempty"`
-	Metadata  any           `json:"metadata,omitempty"`
+	Metadata  Metadata      `json:"metadata,omitempty"`
 	CreatedAt *time.Time    `json:"createdAt,omitempty"`
 	Links     CustomerLinks `json:"_links,omitempty"`
 }
@@ -53,6 +62,7 @@ type ListCustomersOptions struct {
 	ProfileID    string       `url:"profileId,omitempty"`
 	SequenceType SequenceType `url:"sequenceType,omitempty"`
 	RedirectURL  string       `url:"redirectUrl,omitempty"`
+	Testmode     bool         `url:"testmode,omitempty"`
 }
 
 // CustomersList contains a embedded list of customers
@@ -62,7 +72,7 @@ type CustomersList struct {
 	Embedded struct {
 		Customers []*Customer `json:"customers,omitempty"`
 	} `json:"_embedded,omitempty"`
-	Links PaginationLinks `json:"links,omitempty"`
+	Links PaginationLinks `json:"_links,omitempty"`
 }
 
 // CustomersService operates over the customer resource.
@@ -79,7 +89,7 @@ func (cs *CustomersService) Get(ctx context.Context, id string) (res *Response,
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &c); err != nil {
+	if err = res.decode(&c); err != nil {
 		return
 	}
 
@@ -90,13 +100,21 @@ func (cs *CustomersService) Get(ctx context.Context, id string) (res *Response,
 // to use for the Mollie Checkout and Recurring features.
 //
 // See: https://docs.mollie.com/reference/v2/customers-api/create-customer
-func (cs *CustomersService) Create(ctx context.Context, c CreateCustomer) (res *Response, cc *Customer, err error) {
-	res, err = cs.client.post(ctx, "v2/customers", c, nil)
+func (cs *CustomersService) Create(
+	ctx context.Context,
+	c CreateCustomer,
+	reqOpts ...RequestOption,
+) (res *Response, cc *Customer, err error) {
+	if err = c.Validate(); err != nil {
+		return
+	}
+
+	res, err = cs.client.post(ctx, "v2/customers", c, nil, reqOpts...)
 	if err != nil {
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &cc); err != nil {
+	if err = res.decode(&cc); err != nil {
 		return
 	}
 
@@ -106,19 +124,23 @@ func (cs *CustomersService) Create(ctx context.Context, c CreateCustomer) (res *
 // Update an existing customer.
 //
 // See: https://docs.mollie.com/reference/v2/customers-api/update-customer
-func (cs *CustomersService) Update(ctx context.Context, id string, c UpdateCustomer) (
+func (cs *CustomersService) Update(ctx context.Context, id string, c UpdateCustomer, reqOpts ...RequestOption) (
 	res *Response,
 	cc *Customer,
 	err error,
 ) {
+	if err = c.Validate(); err != nil {
+		return
+	}
+
 	u := fmt.Sprintf("v2/customers/%s", id)
 
-	res, err = cs.client.patch(ctx, u, c)
+	res, err = cs.client.patch(ctx, u, c, reqOpts...)
 	if err != nil {
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &cc); err != nil {
+	if err = res.decode(&cc); err != nil {
 		return
 	}
 
@@ -130,10 +152,14 @@ func (cs *CustomersService) Update(ctx context.Context, id string, c UpdateCusto
 // All mandates and subscriptions created for this customer will be canceled as well.
 //
 // See: https://docs.mollie.com/reference/v2/customers-api/delete-customer
-func (cs *CustomersService) Delete(ctx context.Context, id string) (res *Response, err error) {
+func (cs *CustomersService) Delete(
+	ctx context.Context,
+	id string,
+	reqOpts ...RequestOption,
+) (res *Response, err error) {
 	u := fmt.Sprintf("v2/customers/%s", id)
 
-	res, err = cs.client.delete(ctx, u)
+	res, err = cs.client.delete(ctx, u, reqOpts...)
 	if err != nil {
 		return
 	}
@@ -154,7 +180,7 @@ func (cs *CustomersService) List(ctx context.Context, options *ListCustomersOpti
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &cl); err != nil {
+	if err = res.decode(&cl); err != nil {
 		return
 	}
 
@@ -176,7 +202,7 @@ func (cs *CustomersService) GetPayments(ctx context.Context, id string, options
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &pl); err != nil {
+	if err = res.decode(&pl); err != nil {
 		return
 	}
 
@@ -186,25 +212,54 @@ func (cs *CustomersService) GetPayments(ctx context.Context, id string, options
 // CreatePayment creates a payment for the customer.
 //
 // See: https://docs.mollie.com/reference/v2/customers-api/create-customer-payment
-func (cs *CustomersService) CreatePayment(ctx context.Context, id string, p CreatePayment) (
+func (cs *CustomersService) CreatePayment(ctx context.Context, id string, p CreatePayment, reqOpts ...RequestOption) (
 	res *Response,
 	pp *Payment,
 	err error,
 ) {
+	if err = p.Validate(); err != nil {
+		return
+	}
+
 	u := fmt.Sprintf("v2/customers/%s/payments", id)
 
-	res, err = cs.client.post(ctx, u, p, nil)
+	res, err = cs.client.post(ctx, u, p, nil, reqOpts...)
 	if err != nil {
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &pp); err != nil {
+	if err = res.decode(&pp); err != nil {
 		return
 	}
 
 	return
 }
 
+// FirstPayment creates the first payment of a recurring sequence for the
+// customer, the sequence type is always set to FirstSequence.
+//
+// Once the payment is paid Mollie creates a mandate for the customer, its id
+// is available in the MandateID of the payment and allows charging the customer
+// later using RecurringSequence payments, without the customer being present.
+//
+// See: https://docs.mollie.com/payments/recurring#setting-up-the-first-payment
+func (cs *CustomersService) FirstPayment(ctx context.Context, id string, p CreatePayment) (
+	res *Response,
+	pp *Payment,
+	err error,
+) {
+	if p.MandateID != "" {
+		return nil, nil, &ValidationError{
+			Field:  "mandateId",
+			Reason: "must be empty, the mandate is created by the first payment",
+		}
+	}
+
+	p.SequenceType = FirstSequence
+
+	return cs.CreatePayment(ctx, id, p)
+}
+
 func (cs *CustomersService) list(ctx context.Context, uri string, options interface{}) (r *Response, err error) {
 	r, err = cs.client.get(ctx, uri, options)
 	if err != nil {
@@ -2,12 +2,14 @@ package mollie
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
 
 	"github.com/VictorAvelar/mollie-api-go/v4/testdata"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCustomerService_Get(t *testing.T) {
@@ -64,7 +66,7 @@ func TestCustomerService_Get(t *testing.T) {
 				"cst_kEn1PlbGa",
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding Customer: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -158,7 +160,7 @@ func TestCustomersService_Create(t *testing.T) {
 				CreateCustomer{},
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding Customer: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -258,7 +260,7 @@ func TestCustomersService_Update(t *testing.T) {
 				UpdateCustomer{},
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding Customer: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -378,7 +380,7 @@ func TestCustomersService_List(t *testing.T) {
 				},
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding CustomersList: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -411,6 +413,7 @@ func TestCustomersService_List(t *testing.T) {
 			} else {
 				assert.Nil(t, err)
 				assert.IsType(t, &CustomersList{}, cc)
+				assert.Equal(t, "https://api.mollie.com/v2/customers?from=cst_stTC2WHAuS", cc.Links.Next.Href)
 				assert.EqualValues(t, c.args.ctx, res.Request.Context())
 				assert.IsType(t, &http.Response{}, res.Response)
 			}
@@ -577,7 +580,7 @@ func TestCustomerService_GetPayments(t *testing.T) {
 				nil,
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding PaymentList: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -684,7 +687,7 @@ func TestCustomerService_CreatePayment(t *testing.T) {
 				},
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding Payment: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -726,3 +729,32 @@ func TestCustomerService_CreatePayment(t *testing.T) {
 		})
 	}
 }
+
+func TestCustomersService_FirstPayment(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	tMux.HandleFunc("/v2/customers/cst_kEn1PlbGa/payments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		var body CreatePayment
+		require.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, FirstSequence, body.SequenceType)
+
+		_, _ = w.Write([]byte(testdata.GetPaymentResponse))
+	})
+
+	_, p, err := tClient.Customers.FirstPayment(context.Background(), "cst_kEn1PlbGa", CreatePayment{
+		CreateRecurrentPaymentFields: CreateRecurrentPaymentFields{SequenceType: OneOffSequence},
+	})
+	require.Nil(t, err)
+	assert.Equal(t, "tr_WDqYK6vllg", p.ID)
+
+	_, p, err = tClient.Customers.FirstPayment(context.Background(), "cst_kEn1PlbGa", CreatePayment{
+		CreateRecurrentPaymentFields: CreateRecurrentPaymentFields{MandateID: "mdt_h3gAaD5zP"},
+	})
+	assert.Nil(t, p)
+	assert.EqualError(t, err, "validation_error: mandateId must be empty, the mandate is created by the first payment")
+}
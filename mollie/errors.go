@@ -1,6 +1,27 @@
 package mollie
 
-import "fmt"
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Errors matched by the API errors using errors.Is, the returned
+// error is still a *BaseError holding the details sent by Mollie.
+var (
+	// ErrUnauthorized matches 401 responses, the API key or access token is
+	// missing, invalid or expired.
+	ErrUnauthorized = errors.New("unauthorized: invalid or expired credentials")
+	// ErrForbidden matches 403 responses, the credentials are valid but lack
+	// the permissions required by the request.
+	ErrForbidden = errors.New("forbidden: insufficient permissions")
+	// ErrServiceUnavailable matches 503 responses sent while Mollie is under
+	// maintenance, see BaseError.RetryAfter for the time to wait.
+	ErrServiceUnavailable = errors.New("service unavailable: mollie is under maintenance")
+)
 
 // ErrorLinks container references to common urls
 // returned with errors.
@@ -10,12 +31,103 @@ type ErrorLinks struct {
 
 // BaseError contains the general error structure
 // returned by mollie.
+//
+// Content holds the raw response body as received from Mollie,
+// it is useful when the error body does not follow the documented envelope.
+//
+// FieldErrors lists the errors embedded in the response when Mollie reports
+// more than one invalid field, it is empty for single errors.
+//
+// RetryAfter holds the delay sent by Mollie in the Retry-After header,
+// it is zero when the response did not include one.
 type BaseError struct {
-	Status int         `json:"status,omitempty"`
-	Title  string      `json:"title,omitempty"`
-	Detail string      `json:"detail,omitempty"`
-	Field  string      `json:"field,omitempty"`
-	Links  *ErrorLinks `json:"_links,omitempty"`
+	Status      int           `json:"status,omitempty"`
+	Title       string        `json:"title,omitempty"`
+	Detail      string        `json:"detail,omitempty"`
+	Field       string        `json:"field,omitempty"`
+	Links       *ErrorLinks   `json:"_links,omitempty"`
+	FieldErrors []FieldError  `json:"-"`
+	Content     []byte        `json:"-"`
+	RetryAfter  time.Duration `json:"-"`
+}
+
+// FieldError describes the error found on a single field of the request.
+type FieldError struct {
+	Field  string `json:"field,omitempty"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// UnmarshalJSON decodes the error envelope along with the embedded field errors.
+func (be *BaseError) UnmarshalJSON(data []byte) error {
+	type baseError BaseError
+
+	envelope := struct {
+		*baseError
+		Embedded struct {
+			Errors []FieldError `json:"errors,omitempty"`
+		} `json:"_embedded,omitempty"`
+	}{
+		baseError: (*baseError)(be),
+	}
+
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return err
+	}
+
+	be.FieldErrors = envelope.Embedded.Errors
+
+	return nil
+}
+
+// Is reports whether the error matches ErrUnauthorized, ErrForbidden or
+// ErrServiceUnavailable according to its status and content.
+func (be *BaseError) Is(target error) bool {
+	switch target {
+	case ErrUnauthorized:
+		return be.Status == http.StatusUnauthorized
+	case ErrForbidden:
+		return be.Status == http.StatusForbidden
+	case ErrServiceUnavailable:
+		return be.maintenance()
+	default:
+		return false
+	}
+}
+
+// IsRetryable reports whether sending the request again may succeed, which is
+// the case for 429 Too Many Requests and 5xx responses, including maintenance.
+// 501 Not Implemented is excluded, the request will never succeed.
+func (be *BaseError) IsRetryable() bool {
+	return retryableStatus(be.Status)
+}
+
+// retryableStatus reports whether responses with the given status code are transient.
+func retryableStatus(status int) bool {
+	if status == http.StatusNotImplemented {
+		return false
+	}
+
+	return status == http.StatusTooManyRequests || status >= http.StatusInternalServerError
+}
+
+// maintenance reports whether the error is the 503 sent by Mollie during its
+// maintenance windows, which mentions the maintenance in its title or detail.
+func (be *BaseError) maintenance() bool {
+	if be.Status != http.StatusServiceUnavailable {
+		return false
+	}
+
+	return strings.Contains(strings.ToLower(be.Title+" "+be.Detail), "maintenance")
+}
+
+// DocumentationURL returns the link to the Mollie documentation describing
+// the error, empty when the response did not include one.
+func (be *BaseError) DocumentationURL() string {
+	if be.Links == nil {
+		return ""
+	}
+
+	return be.Links.Documentation.String()
 }
 
 // Error interface compliance.
@@ -28,3 +140,15 @@ func (be *BaseError) Error() string {
 
 	return str
 }
+
+// ValidationError is returned when a request payload fails the checks
+// performed by the client before sending it to Mollie.
+type ValidationError struct {
+	Field  string
+	Reason string
+}
+
+// Error interface compliance.
+func (ve *ValidationError) Error() string {
+	return fmt.Sprintf("validation_error: %s %s", ve.Field, ve.Reason)
+}
@@ -1,8 +1,12 @@
 package mollie
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/VictorAvelar/mollie-api-go/v4/testdata"
 	"github.com/stretchr/testify/assert"
@@ -39,3 +43,258 @@ func TestBaseError(t *testing.T) {
 		})
 	}
 }
+
+func TestBaseError_As(t *testing.T) {
+	setEnv()
+	setup()
+	defer unsetEnv()
+	defer teardown()
+
+	tMux.HandleFunc("/v2/payments/tr_I_dont_exist", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(testdata.UnprocessableEntityErrorResponse))
+	})
+
+	_, _, err := tClient.Payments.Get(context.Background(), "tr_I_dont_exist", nil)
+
+	var apiErr *BaseError
+	if assert.True(t, errors.As(err, &apiErr)) {
+		assert.Equal(t, http.StatusUnprocessableEntity, apiErr.Status)
+		assert.Equal(t, "amount", apiErr.Field)
+		assert.Equal(t, "https://docs.mollie.com/errors", apiErr.Links.Documentation.Href)
+		assert.JSONEq(t, testdata.UnprocessableEntityErrorResponse, string(apiErr.Content))
+	}
+}
+
+func TestBaseError_FieldErrors(t *testing.T) {
+	cases := []struct {
+		name    string
+		body    string
+		want    []FieldError
+		chunked bool
+	}{
+		{
+			"embedded field errors are decoded",
+			testdata.UnprocessableEntityMultipleErrorsResponse,
+			[]FieldError{
+				{Field: "amount.value", Detail: "The amount is higher than the maximum"},
+				{Field: "billingAddress.email", Detail: "The email address is invalid"},
+			},
+			false,
+		},
+		{
+			"single errors have no field errors",
+			testdata.UnprocessableEntityErrorResponse,
+			nil,
+			false,
+		},
+		{
+			"chunked responses without content length are decoded",
+			testdata.UnprocessableEntityMultipleErrorsResponse,
+			[]FieldError{
+				{Field: "amount.value", Detail: "The amount is higher than the maximum"},
+				{Field: "billingAddress.email", Detail: "The email address is invalid"},
+			},
+			true,
+		},
+	}
+
+	for _, c := range cases {
+		setEnv()
+		setup()
+
+		t.Run(c.name, func(t *testing.T) {
+			tMux.HandleFunc("/v2/payments", func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/hal+json")
+				w.WriteHeader(http.StatusUnprocessableEntity)
+
+				if c.chunked {
+					w.(http.Flusher).Flush()
+				}
+
+				_, _ = w.Write([]byte(c.body))
+			})
+
+			_, _, err := tClient.Payments.Create(context.Background(), CreatePayment{}, nil)
+
+			var apiErr *BaseError
+			if assert.True(t, errors.As(err, &apiErr)) {
+				assert.Equal(t, http.StatusUnprocessableEntity, apiErr.Status)
+				assert.Equal(t, c.want, apiErr.FieldErrors)
+				assert.Equal(t, "https://docs.mollie.com/errors", apiErr.Links.Documentation.Href)
+				assert.NotEmpty(t, apiErr.Detail)
+			}
+		})
+
+		unsetEnv()
+		teardown()
+	}
+}
+
+func TestBaseError_CredentialErrors(t *testing.T) {
+	cases := []struct {
+		name   string
+		status int
+		body   string
+		target error
+		other  error
+		docs   string
+	}{
+		{
+			"unauthorized responses match ErrUnauthorized",
+			http.StatusUnauthorized,
+			testdata.UnauthorizedErrorResponse,
+			ErrUnauthorized,
+			ErrForbidden,
+			"https://docs.mollie.com/overview/authentication",
+		},
+		{
+			"forbidden responses match ErrForbidden",
+			http.StatusForbidden,
+			testdata.ForbiddenErrorResponse,
+			ErrForbidden,
+			ErrUnauthorized,
+			"https://docs.mollie.com/connect/permissions",
+		},
+	}
+
+	for _, c := range cases {
+		setEnv()
+		setup()
+
+		t.Run(c.name, func(t *testing.T) {
+			tMux.HandleFunc("/v2/methods", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "GET")
+				w.WriteHeader(c.status)
+				_, _ = w.Write([]byte(c.body))
+			})
+
+			err := tClient.Ping(context.Background())
+			assert.ErrorIs(t, err, c.target)
+			assert.NotErrorIs(t, err, c.other)
+			assert.ErrorIs(t, tClient.Verify(context.Background()), c.target)
+
+			var apiErr *BaseError
+			if assert.True(t, errors.As(err, &apiErr)) {
+				assert.Equal(t, c.status, apiErr.Status)
+				assert.Equal(t, c.docs, apiErr.DocumentationURL())
+			}
+		})
+
+		unsetEnv()
+		teardown()
+	}
+}
+
+func TestClient_Ping(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	tMux.HandleFunc("/v2/methods", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = w.Write([]byte(testdata.ListMethodsResponse))
+	})
+
+	assert.Nil(t, tClient.Ping(context.Background()))
+	assert.Nil(t, tClient.Verify(context.Background()))
+
+	var be BaseError
+	assert.Empty(t, be.DocumentationURL())
+	assert.NotErrorIs(t, &BaseError{Status: http.StatusNotFound}, ErrUnauthorized)
+}
+
+func TestBaseError_ServiceUnavailable(t *testing.T) {
+	cases := []struct {
+		name        string
+		status      int
+		body        string
+		retryAfter  string
+		maintenance bool
+		wait        time.Duration
+		retryable   bool
+	}{
+		{
+			"maintenance responses match ErrServiceUnavailable",
+			http.StatusServiceUnavailable,
+			testdata.ServiceUnavailableErrorResponse,
+			"120",
+			true,
+			2 * time.Minute,
+			true,
+		},
+		{
+			"transient unavailability does not match ErrServiceUnavailable",
+			http.StatusServiceUnavailable,
+			testdata.TransientServiceUnavailableErrorResponse,
+			"",
+			false,
+			0,
+			true,
+		},
+		{
+			"maintenance mentioned outside the error message does not match ErrServiceUnavailable",
+			http.StatusServiceUnavailable,
+			testdata.StatusPageServiceUnavailableErrorResponse,
+			"",
+			false,
+			0,
+			true,
+		},
+		{
+			"not implemented errors are not retryable",
+			http.StatusNotImplemented,
+			testdata.NotImplementedErrorResponse,
+			"",
+			false,
+			0,
+			false,
+		},
+		{
+			"server errors are retryable",
+			http.StatusInternalServerError,
+			testdata.InternalServerErrorResponse,
+			"",
+			false,
+			0,
+			true,
+		},
+		{
+			"client errors are not retryable",
+			http.StatusUnprocessableEntity,
+			testdata.UnprocessableEntityErrorResponse,
+			"",
+			false,
+			0,
+			false,
+		},
+	}
+
+	for _, c := range cases {
+		setEnv()
+		setup()
+
+		t.Run(c.name, func(t *testing.T) {
+			tMux.HandleFunc("/v2/methods", func(w http.ResponseWriter, r *http.Request) {
+				if c.retryAfter != "" {
+					w.Header().Set("Retry-After", c.retryAfter)
+				}
+				w.WriteHeader(c.status)
+				_, _ = w.Write([]byte(c.body))
+			})
+
+			err := tClient.Ping(context.Background())
+			assert.Equal(t, c.maintenance, errors.Is(err, ErrServiceUnavailable))
+
+			var apiErr *BaseError
+			if assert.True(t, errors.As(err, &apiErr)) {
+				assert.Equal(t, c.wait, apiErr.RetryAfter)
+				assert.Equal(t, c.retryable, apiErr.IsRetryable())
+			}
+		})
+
+		unsetEnv()
+		teardown()
+	}
+}
@@ -0,0 +1,91 @@
+package mollie
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/google/go-querystring/query"
+)
+
+// Fetch sends a request to any Mollie endpoint and decodes the response into
+// out, it allows to reach the endpoints not wrapped by the client yet.
+//
+// The path is relative to the client base url, e.g. "v2/chargebacks", body
+// is encoded as JSON when not nil and empty responses leave out untouched.
+// Requests are built and sent like the ones of the services, authentication,
+// idempotency keys, retries and the remaining client options included.
+func Fetch[T any](ctx context.Context, c *Client, method, path string, body any, out *T) (*Response, error) {
+	res, err := c.send(ctx, method, path, nil, body)
+	if err != nil {
+		return res, err
+	}
+
+	if out == nil || res.empty() {
+		return res, nil
+	}
+
+	return res, res.decode(out)
+}
+
+// doInto sends the request and decodes the response into a new T.
+func doInto[T any](ctx context.Context, c *Client, method, uri string, options, body any, opts ...RequestOption) (
+	res *Response,
+	out *T,
+	err error,
+) {
+	res, err = c.send(ctx, method, uri, options, body, opts...)
+	if err != nil {
+		return
+	}
+
+	if err = res.decode(&out); err != nil {
+		return
+	}
+
+	return
+}
+
+// MaxListLimit is the maximum number of resources Mollie returns per page.
+const MaxListLimit = 250
+
+// send builds the request, encoding the options as query string and applying
+// the request options, and sends it.
+//
+// The limit option is clamped to [1, MaxListLimit], Mollie rejects any other
+// value, a zero limit is omitted so Mollie applies its default page size.
+func (c *Client) send(ctx context.Context, method, uri string, options, body any, opts ...RequestOption) (
+	*Response,
+	error,
+) {
+	if options != nil {
+		v, _ := query.Values(options)
+		clampLimit(v)
+		uri = fmt.Sprintf("%s?%s", uri, v.Encode())
+	}
+
+	req, err := c.NewAPIRequest(ctx, method, uri, body, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Do(req)
+}
+
+// clampLimit bounds the limit query parameter to the values accepted by Mollie.
+func clampLimit(v url.Values) {
+	l, err := strconv.Atoi(v.Get("limit"))
+	if err != nil {
+		return
+	}
+
+	switch {
+	case l < 1:
+		l = 1
+	case l > MaxListLimit:
+		l = MaxListLimit
+	}
+
+	v.Set("limit", strconv.Itoa(l))
+}
@@ -0,0 +1,133 @@
+package mollie
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/VictorAvelar/mollie-api-go/v4/testdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetch(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	tMux.HandleFunc("/v2/chargebacks", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, AuthHeader, "Bearer token_X12b31ggg23")
+		testMethod(t, r, "GET")
+		testQuery(t, r, "limit=5")
+		_, _ = w.Write([]byte(testdata.ListChargebacksResponse))
+	})
+
+	var cl ChargebacksList
+
+	res, err := Fetch(context.Background(), tClient, http.MethodGet, "v2/chargebacks?limit=5", nil, &cl)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, 3, cl.Count)
+}
+
+func TestFetch_Body(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	type future struct {
+		Resource string `json:"resource"`
+		Name     string `json:"name"`
+	}
+
+	tMux.HandleFunc("/v2/futures", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		var got future
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		assert.Equal(t, "x", got.Name)
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"resource":"future","name":"x"}`))
+	})
+
+	tMux.HandleFunc("/v2/futures/ftr_1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	var out future
+
+	_, err := Fetch(context.Background(), tClient, http.MethodPost, "v2/futures", future{Name: "x"}, &out)
+	require.NoError(t, err)
+	assert.Equal(t, future{Resource: "future", Name: "x"}, out)
+
+	res, err := Fetch[future](context.Background(), tClient, http.MethodDelete, "v2/futures/ftr_1", nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, res.StatusCode)
+}
+
+func TestFetch_Errors(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	tMux.HandleFunc("/v2/chargebacks", errorHandler)
+
+	var cl ChargebacksList
+
+	_, err := Fetch(context.Background(), tClient, http.MethodGet, "v2/chargebacks", nil, &cl)
+	assert.EqualError(t, err, "500 Internal Server Error: An internal server error occurred while processing your request.")
+}
+
+func TestClient_ListLimit(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+
+	cases := []struct {
+		name  string
+		limit int
+		query string
+	}{
+		{"limits above the maximum are clamped", 500, "limit=250"},
+		{"negative limits are clamped", -3, "limit=1"},
+		{"valid limits are preserved", 50, "limit=50"},
+		{"zero limits are omitted", 0, ""},
+	}
+
+	for _, c := range cases {
+		setup()
+		defer teardown()
+
+		t.Run(c.name, func(t *testing.T) {
+			tMux.HandleFunc("/v2/chargebacks", func(w http.ResponseWriter, r *http.Request) {
+				testQuery(t, r, c.query)
+				_, _ = w.Write([]byte(testdata.ListChargebacksResponse))
+			})
+
+			_, _, err := tClient.Chargebacks.List(context.Background(), &ListChargebacksOptions{Limit: c.limit})
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestChargebacksService_ListAll_MaxLimit(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	tMux.HandleFunc("/v2/chargebacks", func(w http.ResponseWriter, r *http.Request) {
+		testQuery(t, r, "limit=250")
+		_, _ = w.Write([]byte(testdata.ListChargebacksLastPageResponse))
+	})
+
+	it := tClient.Chargebacks.ListAll(nil)
+
+	_, err := it.Next(context.Background())
+	require.NoError(t, err)
+}
@@ -0,0 +1,70 @@
+package mollie
+
+import "context"
+
+// PaymentsAPI describes the payment operations of PaymentsService, depend on
+// it instead of *PaymentsService to replace the service with a fake in tests.
+//
+// Fakes can return a nil *Response, the services never do when err is nil.
+type PaymentsAPI interface {
+	Get(ctx context.Context, id string, opts *PaymentOptions) (*Response, *Payment, error)
+	Create(ctx context.Context, p CreatePayment, opts *PaymentOptions, reqOpts ...RequestOption) (
+		*Response,
+		*Payment,
+		error,
+	)
+	Update(ctx context.Context, id string, up UpdatePayment, reqOpts ...RequestOption) (*Response, *Payment, error)
+	Cancel(ctx context.Context, id string, reqOpts ...RequestOption) (*Response, *Payment, error)
+	List(ctx context.Context, opts *ListPaymentsOptions) (*Response, *PaymentList, error)
+}
+
+// ChargebacksAPI describes the chargeback operations of ChargebacksService,
+// depend on it instead of *ChargebacksService to replace the service with a
+// fake in tests.
+//
+// The iterators are left out, they are bound to the concrete service.
+type ChargebacksAPI interface {
+	Get(ctx context.Context, payment, chargeback string, opts *ChargebackOptions) (*Response, *Chargeback, error)
+	List(ctx context.Context, options *ListChargebacksOptions) (*Response, *ChargebacksList, error)
+	ListForPayment(ctx context.Context, payment string, options *ListChargebacksOptions) (
+		*Response,
+		*ChargebacksList,
+		error,
+	)
+	ListNext(ctx context.Context, cl *ChargebacksList) (*Response, *ChargebacksList, error)
+}
+
+// RefundsAPI describes the payment refund operations of RefundsService,
+// depend on it instead of *RefundsService to replace the service with a fake
+// in tests.
+type RefundsAPI interface {
+	List(ctx context.Context, opts *ListRefundsOptions) (*Response, *RefundsList, error)
+	GetPaymentRefund(ctx context.Context, paymentID, refundID string, opts *PaymentRefundOptions) (
+		*Response,
+		*Refund,
+		error,
+	)
+	ListPaymentRefunds(ctx context.Context, paymentID string, opts *ListRefundsOptions) (
+		*Response,
+		*RefundsList,
+		error,
+	)
+	CreatePaymentRefund(
+		ctx context.Context,
+		paymentID string,
+		re CreatePaymentRefund,
+		options *PaymentRefundOptions,
+		reqOpts ...RequestOption,
+	) (
+		*Response,
+		*Refund,
+		error,
+	)
+	CancelPaymentRefund(ctx context.Context, paymentID, refundID string, reqOpts ...RequestOption) (*Response, error)
+}
+
+var (
+	_ PaymentsAPI    = (*PaymentsService)(nil)
+	_ ChargebacksAPI = (*ChargebacksService)(nil)
+	_ RefundsAPI     = (*RefundsService)(nil)
+)
@@ -0,0 +1,45 @@
+package mollie
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeChargebacks struct {
+	ChargebacksAPI
+	list *ChargebacksList
+}
+
+func (f *fakeChargebacks) ListForPayment(ctx context.Context, payment string, options *ListChargebacksOptions) (
+	*Response,
+	*ChargebacksList,
+	error,
+) {
+	return nil, f.list, nil
+}
+
+func TestChargebacksAPI_Fake(t *testing.T) {
+	count := func(ctx context.Context, api ChargebacksAPI, payment string) (int, error) {
+		_, cl, err := api.ListForPayment(ctx, payment, nil)
+		if err != nil {
+			return 0, err
+		}
+
+		return cl.Count, nil
+	}
+
+	n, err := count(context.Background(), &fakeChargebacks{list: &ChargebacksList{Count: 2}}, "tr_WDqYK6vllg")
+	require.Nil(t, err)
+	assert.Equal(t, 2, n)
+
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	var api ChargebacksAPI = tClient.Chargebacks
+	assert.NotNil(t, api)
+}
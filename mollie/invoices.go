@@ -2,7 +2,6 @@ package mollie
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 )
 
@@ -31,6 +30,9 @@ type Invoice struct {
 	Lines       []*LineItem   `json:"lines,omitempty"`
 	Status      InvoiceStatus `json:"status,omitempty"`
 	Links       InvoiceLinks  `json:"_links,omitempty"`
+	Embedded    struct {
+		Settlements []*Settlement `json:"settlements,omitempty"`
+	} `json:"_embedded,omitempty"`
 }
 
 // LineItem product details.
@@ -50,12 +52,20 @@ type InvoiceLinks struct {
 	Documentation *URL `json:"documentation,omitempty"`
 }
 
+// InvoiceOptions describes get invoice endpoint valid query string parameters.
+//
+// Use IncludeSettlements to embed the settlements billed by the invoice.
+type InvoiceOptions struct {
+	Include []IncludeValue `url:"include,omitempty,comma"`
+}
+
 // ListInvoicesOptions describes list invoices endpoint valid query string parameters.
 type ListInvoicesOptions struct {
-	Limit     int64  `url:"limit,omitempty"`
-	Reference string `url:"reference,omitempty"`
-	Year      string `url:"year,omitempty"`
-	From      string `url:"from,omitempty"`
+	Limit     int64          `url:"limit,omitempty"`
+	Reference string         `url:"reference,omitempty"`
+	Year      string         `url:"year,omitempty"`
+	From      string         `url:"from,omitempty"`
+	Include   []IncludeValue `url:"include,omitempty,comma"`
 }
 
 // InvoicesList describes how a list of invoices will be retrieved by Mollie.
@@ -71,15 +81,27 @@ type InvoicesList struct {
 type InvoicesService service
 
 // Get retrieve details of an invoice, using the invoice’s identifier.
+//
+// Deprecated: use GetWithOptions instead.
 func (is *InvoicesService) Get(ctx context.Context, id string) (res *Response, i *Invoice, err error) {
+	return is.GetWithOptions(ctx, id, nil)
+}
+
+// GetWithOptions retrieve details of an invoice, using the invoice’s identifier,
+// the options allow embedding the settlements billed by the invoice.
+func (is *InvoicesService) GetWithOptions(ctx context.Context, id string, options *InvoiceOptions) (
+	res *Response,
+	i *Invoice,
+	err error,
+) {
 	u := fmt.Sprintf("v2/invoices/%s", id)
 
-	res, err = is.client.get(ctx, u, nil)
+	res, err = is.client.get(ctx, u, options)
 	if err != nil {
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &i); err != nil {
+	if err = res.decode(&i); err != nil {
 		return
 	}
 
@@ -97,7 +119,7 @@ func (is *InvoicesService) List(ctx context.Context, options *ListInvoicesOption
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &il); err != nil {
+	if err = res.decode(&il); err != nil {
 		return
 	}
 
@@ -15,11 +15,28 @@ import (
 func TestInvoicesService_Get(t *testing.T) {
 	setEnv()
 	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	tMux.HandleFunc("/v2/invoices/inv_xBEbP9rvAq", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, "")
+		_, _ = w.Write([]byte(testdata.GetInvoiceResponse))
+	})
+
+	_, i, err := tClient.Invoices.Get(context.Background(), "inv_xBEbP9rvAq")
+	assert.Nil(t, err)
+	assert.Equal(t, "inv_xBEbP9rvAq", i.ID)
+}
+
+func TestInvoicesService_GetWithOptions(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
 
 	type args struct {
 		ctx     context.Context
 		invoice string
-		options *ListInvoicesOptions
+		options *InvoiceOptions
 	}
 
 	cases := []struct {
@@ -51,6 +68,25 @@ func TestInvoicesService_Get(t *testing.T) {
 				_, _ = w.Write([]byte(testdata.GetInvoiceResponse))
 			},
 		},
+		{
+			"get invoice includes the settlements when requested",
+			args{
+				context.Background(),
+				"inv_xBEbP9rvAq",
+				&InvoiceOptions{
+					Include: []IncludeValue{IncludeSettlements},
+				},
+			},
+			false,
+			nil,
+			noPre,
+			func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "GET")
+				testQuery(t, r, "include=settlements")
+
+				_, _ = w.Write([]byte(testdata.GetInvoiceResponse))
+			},
+		},
 		{
 			"get invoice, an error is returned from the server",
 			args{
@@ -71,7 +107,7 @@ func TestInvoicesService_Get(t *testing.T) {
 				nil,
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding Invoice: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -96,7 +132,7 @@ func TestInvoicesService_Get(t *testing.T) {
 			c.pre()
 			tMux.HandleFunc(fmt.Sprintf("/v2/invoices/%s", c.args.invoice), c.handler)
 
-			res, i, err := tClient.Invoices.Get(c.args.ctx, c.args.invoice)
+			res, i, err := tClient.Invoices.GetWithOptions(c.args.ctx, c.args.invoice, c.args.options)
 			if c.wantErr {
 				assert.NotNil(t, err)
 				assert.EqualError(t, err, c.err.Error())
@@ -192,7 +228,7 @@ func TestInvoicesService_List(t *testing.T) {
 				nil,
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding InvoicesList: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
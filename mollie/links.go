@@ -0,0 +1,131 @@
+package mollie
+
+import (
+	"encoding/json"
+	"net/url"
+	"reflect"
+	"strings"
+)
+
+// Links contains every link of a resource keyed by its name, including
+// the links not yet described by the typed links structs.
+type Links map[string]*URL
+
+// Get returns the link with the given name or nil when it is not present.
+func (l Links) Get(name string) *URL {
+	return l[name]
+}
+
+// String returns the href of the link, nil links return an empty string.
+func (u *URL) String() string {
+	if u == nil {
+		return ""
+	}
+
+	return u.Href
+}
+
+// Path returns the path of the link without query string or trailing slash,
+// e.g. "/v2/settlements/stl_jDk30akdN". Nil or invalid links return an empty string.
+func (u *URL) Path() string {
+	if u == nil {
+		return ""
+	}
+
+	p, err := url.Parse(u.Href)
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimRight(p.Path, "/")
+}
+
+// ResourceID returns the id of the resource the link points to, which
+// is the last segment of the link path, e.g. the settlement id of
+// a chargeback's settlement link.
+func (u *URL) ResourceID() string {
+	p := u.Path()
+
+	return p[strings.LastIndex(p, "/")+1:]
+}
+
+// NextCursor returns the from cursor of the next page, decoded from the query
+// string of the next link, and false when there is no next page.
+//
+// The cursor can be stored and passed later as the From option of the list
+// call, e.g. ListChargebacksOptions.From, to resume the pagination.
+func (pl PaginationLinks) NextCursor() (string, bool) {
+	if pl.Next == nil {
+		return "", false
+	}
+
+	u, err := url.Parse(pl.Next.Href)
+	if err != nil {
+		return "", false
+	}
+
+	from := u.Query().Get("from")
+
+	return from, from != ""
+}
+
+// unmarshalLinks decodes data into typed, a pointer to a struct of *URL fields
+// named by their json tags, and returns the links it does not describe, nil
+// when every link is described by typed. The links are returned by pointer so
+// the typed links structs holding them remain comparable.
+func unmarshalLinks(data []byte, typed any) (*Links, error) {
+	var all Links
+	if err := json.Unmarshal(data, &all); err != nil {
+		return nil, err
+	}
+
+	v := reflect.ValueOf(typed).Elem()
+
+	for i := 0; i < v.NumField(); i++ {
+		name, ok := linkName(v.Type().Field(i))
+		if !ok {
+			continue
+		}
+
+		v.Field(i).Set(reflect.ValueOf(all[name]))
+		delete(all, name)
+	}
+
+	if len(all) == 0 {
+		return nil, nil
+	}
+
+	return &all, nil
+}
+
+// lookupLink returns the link of typed with the given json name, falling back
+// to the links not described by typed.
+func lookupLink(typed any, extra *Links, name string) *URL {
+	v := reflect.ValueOf(typed).Elem()
+
+	for i := 0; i < v.NumField(); i++ {
+		if n, ok := linkName(v.Type().Field(i)); ok && n == name {
+			return v.Field(i).Interface().(*URL)
+		}
+	}
+
+	if extra == nil {
+		return nil
+	}
+
+	return extra.Get(name)
+}
+
+// linkName returns the json name of a *URL field of a typed links struct.
+func linkName(f reflect.StructField) (string, bool) {
+	if !f.IsExported() || f.Type != reflect.TypeOf((*URL)(nil)) {
+		return "", false
+	}
+
+	name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+	if name == "" {
+		name = f.Name
+	}
+
+	return name, true
+}
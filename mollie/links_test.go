@@ -0,0 +1,214 @@
+package mollie
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/VictorAvelar/mollie-api-go/v4/testdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestURL_String(t *testing.T) {
+	var u *URL
+
+	assert.Equal(t, "", u.String())
+	assert.Equal(t, "https://api.mollie.com/v2/payments/tr_WDqYK6vllg", (&URL{Href: "https://api.mollie.com/v2/payments/tr_WDqYK6vllg"}).String())
+}
+
+func TestURL_ResourceID(t *testing.T) {
+	cases := []struct {
+		name string
+		url  *URL
+		path string
+		id   string
+	}{
+		{
+			"links to nested resources",
+			&URL{Href: "https://api.mollie.com/v2/payments/tr_WDqYK6vllg/chargebacks/chb_n9z0tp"},
+			"/v2/payments/tr_WDqYK6vllg/chargebacks/chb_n9z0tp",
+			"chb_n9z0tp",
+		},
+		{
+			"links with trailing slashes",
+			&URL{Href: "https://api.mollie.com/v2/settlements/stl_jDk30akdN/"},
+			"/v2/settlements/stl_jDk30akdN",
+			"stl_jDk30akdN",
+		},
+		{
+			"links with query strings",
+			&URL{Href: "https://api.mollie.com/v2/payments/tr_WDqYK6vllg?embed=refunds#top"},
+			"/v2/payments/tr_WDqYK6vllg",
+			"tr_WDqYK6vllg",
+		},
+		{
+			"invalid links",
+			&URL{Href: "h%%s12"},
+			"",
+			"",
+		},
+		{
+			"missing links",
+			nil,
+			"",
+			"",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.path, c.url.Path())
+			assert.Equal(t, c.id, c.url.ResourceID())
+		})
+	}
+}
+
+func TestPaginationLinks_NextCursor(t *testing.T) {
+	cases := []struct {
+		name   string
+		next   *URL
+		cursor string
+		ok     bool
+	}{
+		{
+			"next links with a cursor",
+			&URL{Href: "https://api.mollie.com/v2/chargebacks?from=chb_xvb2kq&limit=1"},
+			"chb_xvb2kq",
+			true,
+		},
+		{
+			"url encoded cursors",
+			&URL{Href: "https://api.mollie.com/v2/settlements/stl_jDk30akdN/chargebacks?limit=5&from=chb%2Fn9%20z0tp"},
+			"chb/n9 z0tp",
+			true,
+		},
+		{"next links without cursor", &URL{Href: "https://api.mollie.com/v2/chargebacks?limit=1"}, "", false},
+		{"invalid next links", &URL{Href: "h%%s12"}, "", false},
+		{"missing next links", nil, "", false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			cursor, ok := PaginationLinks{Next: c.next}.NextCursor()
+			assert.Equal(t, c.cursor, cursor)
+			assert.Equal(t, c.ok, ok)
+		})
+	}
+}
+
+func TestPaginationLinks_NextCursor_Resume(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	tMux.HandleFunc("/v2/chargebacks", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("from") == "" {
+			_, _ = w.Write([]byte(testdata.ListChargebacksFirstPageResponse))
+
+			return
+		}
+
+		testQuery(t, r, "from=chb_xvb2kq&limit=1")
+		_, _ = w.Write([]byte(testdata.ListChargebacksLastPageResponse))
+	})
+
+	_, first, err := tClient.Chargebacks.List(context.Background(), &ListChargebacksOptions{Limit: 1})
+	require.Nil(t, err)
+
+	cursor, ok := first.Links.NextCursor()
+	require.True(t, ok)
+
+	_, last, err := tClient.Chargebacks.List(context.Background(), &ListChargebacksOptions{From: cursor, Limit: 1})
+	require.Nil(t, err)
+	assert.Equal(t, "chb_xvb2kq", last.Embedded.Chargebacks[0].ID)
+
+	_, ok = last.Links.NextCursor()
+	assert.False(t, ok)
+}
+
+func TestChargeback_SettlementID(t *testing.T) {
+	var cb Chargeback
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"_links": {
+			"settlement": {"href": "https://api.mollie.com/v2/settlements/stl_jDk30akdN", "type": "application/hal+json"}
+		}
+	}`), &cb))
+
+	assert.Equal(t, "stl_jDk30akdN", cb.Links.Settlement.ResourceID())
+	assert.Equal(t, "", (&Chargeback{}).Links.Settlement.ResourceID())
+}
+
+func TestChargeback_Link(t *testing.T) {
+	var cb Chargeback
+	require.NoError(t, json.Unmarshal([]byte(testdata.GetChargebackResponse), &cb))
+
+	assert.Equal(t, cb.Links.Payment, cb.Link("payment"))
+	assert.Equal(t, "tr_WDqYK6vllg", cb.Link("payment").ResourceID())
+	assert.Nil(t, cb.Link("settlement"))
+
+	var nilCb *Chargeback
+	assert.Nil(t, nilCb.Link("payment"))
+}
+
+func TestChargebackLinks_Equal(t *testing.T) {
+	var cb Chargeback
+	require.NoError(t, json.Unmarshal([]byte(testdata.GetChargebackResponse), &cb))
+
+	assert.Equal(t, ChargebackLinks{
+		Self: &URL{
+			Href: "https://api.mollie.com/v2/payments/tr_WDqYK6vllg/chargebacks/chb_n9z0tp",
+			Type: "application/hal+json",
+		},
+		Payment: &URL{Href: "https://api.mollie.com/v2/payments/tr_WDqYK6vllg", Type: "application/hal+json"},
+		Documentation: &URL{
+			Href: "https://docs.mollie.com/reference/v2/chargebacks-api/get-payment-chargeback",
+			Type: "text/html",
+		},
+	}, cb.Links)
+
+	copied := cb
+	assert.True(t, copied == cb)
+	assert.True(t, map[ChargebackLinks]bool{cb.Links: true}[copied.Links])
+
+	var pl PaymentLinks
+	var rl RefundLinks
+	assert.True(t, pl == PaymentLinks{} && rl == RefundLinks{})
+
+	built := &ChargebackLinks{Settlement: &URL{Href: "https://api.mollie.com/v2/settlements/stl_jDk30akdN"}}
+	assert.Equal(t, built.Settlement, built.Link("settlement"))
+	assert.Nil(t, built.Link("receipt"))
+}
+
+func TestLinks_ForwardCompatibility(t *testing.T) {
+	content := `{
+		"_links": {
+			"self": {"href": "https://api.mollie.com/v2/refunds/re_4qqhO89gsT", "type": "application/hal+json"},
+			"receipt": {"href": "https://api.mollie.com/v2/receipts/rec_123", "type": "application/hal+json"}
+		}
+	}`
+
+	var r Refund
+	require.NoError(t, json.Unmarshal([]byte(content), &r))
+
+	assert.Equal(t, "https://api.mollie.com/v2/refunds/re_4qqhO89gsT", r.Links.Self.String())
+	assert.Equal(t, "https://api.mollie.com/v2/receipts/rec_123", r.Link("receipt").String())
+
+	var p Payment
+	require.NoError(t, json.Unmarshal([]byte(testdata.GetPaymentResponse), &p))
+	assert.Equal(t, p.Links.Checkout, p.Link("checkout"))
+}
+
+func TestPaymentLinks_BankTransfer(t *testing.T) {
+	var p Payment
+	require.NoError(t, json.Unmarshal([]byte(testdata.CreateOrderPaymentResponse), &p))
+
+	require.NotNil(t, p.Links.Status)
+	assert.Equal(t, "https://www.mollie.com/paymentscreen/banktransfer/status/fgnwdh", p.Links.Status.String())
+	require.NotNil(t, p.Links.PayOnline)
+	assert.Equal(t, "https://www.mollie.com/paymentscreen/banktransfer/pay-online/fgnwdh", p.Links.PayOnline.String())
+	assert.Equal(t, p.Links.PayOnline, p.Link("payOnline"))
+	assert.Nil(t, p.Links.Dashboard)
+}
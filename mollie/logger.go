@@ -0,0 +1,64 @@
+package mollie
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httputil"
+)
+
+const redacted = "[REDACTED]"
+
+// Logger receives every request sent to Mollie together with the received
+// response, or the transport error when no response was received.
+//
+// The Authorization header of the request is always redacted.
+type Logger func(req *http.Request, res *http.Response, err error)
+
+// WithLogger registers a function called after each request sent by the client,
+// retries included. Passing nil disables the logging.
+func (c *Client) WithLogger(fn Logger) {
+	c.logger = fn
+}
+
+// NewDumpLogger returns a Logger that writes the full dump of the requests
+// and responses, bodies included, to w.
+func NewDumpLogger(w io.Writer) Logger {
+	return func(req *http.Request, res *http.Response, err error) {
+		if dump, derr := httputil.DumpRequestOut(req, req.Body != nil); derr == nil {
+			fmt.Fprintf(w, "%s\n", dump)
+		}
+
+		if err != nil {
+			fmt.Fprintf(w, "error: %v\n", err)
+
+			return
+		}
+
+		if dump, derr := httputil.DumpResponse(res, true); derr == nil {
+			fmt.Fprintf(w, "%s\n", dump)
+		}
+	}
+}
+
+// log passes a redacted copy of the request to the registered logger.
+func (c *Client) log(req *http.Request, res *http.Response, err error) {
+	if c.logger == nil {
+		return
+	}
+
+	r := req.Clone(req.Context())
+	r.Body = nil
+
+	if req.GetBody != nil {
+		if body, berr := req.GetBody(); berr == nil {
+			r.Body = body
+		}
+	}
+
+	if r.Header.Get(AuthHeader) != "" {
+		r.Header.Set(AuthHeader, redacted)
+	}
+
+	c.logger(r, res, err)
+}
@@ -0,0 +1,68 @@
+package mollie
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/VictorAvelar/mollie-api-go/v4/testdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_WithLogger(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	tMux.HandleFunc("/v2/payments", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, AuthHeader, "Bearer token_X12b31ggg23")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(testdata.GetPaymentResponse))
+	})
+
+	var out bytes.Buffer
+
+	tClient.WithLogger(NewDumpLogger(&out))
+
+	_, p, err := tClient.Payments.Create(context.Background(), CreatePayment{Description: "Order #12345"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "tr_WDqYK6vllg", p.ID)
+
+	logged := out.String()
+	assert.NotContains(t, logged, "token_X12b31ggg23")
+	assert.Contains(t, logged, "Authorization: [REDACTED]")
+	assert.Contains(t, logged, "POST /v2/payments")
+	assert.Contains(t, logged, `"description":"Order #12345"`)
+	assert.Contains(t, logged, "201 Created")
+	assert.Contains(t, logged, `"id": "tr_WDqYK6vllg"`)
+}
+
+func TestClient_WithLogger_Errors(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	var (
+		calls  int
+		logErr error
+	)
+
+	tClient.WithLogger(func(req *http.Request, res *http.Response, err error) {
+		calls++
+		logErr = err
+
+		assert.Equal(t, redacted, req.Header.Get(AuthHeader))
+		assert.Nil(t, res)
+	})
+
+	tClient.BaseURL, _ = tClient.BaseURL.Parse("http://127.0.0.1:0/")
+
+	_, _, err := tClient.Payments.Get(context.Background(), "tr_WDqYK6vllg", nil)
+	require.Error(t, err)
+	assert.Equal(t, 1, calls)
+	assert.Error(t, logErr)
+}
@@ -2,7 +2,6 @@ package mollie
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"time"
 )
@@ -20,6 +19,38 @@ type CreateMandate struct {
 	CreateMandateAccessTokenFields
 }
 
+// Validate checks the method specific fields required by Mollie are present.
+//
+// Direct debit mandates require the consumer name and account, while PayPal
+// mandates require the consumer name, email and billing agreement id.
+func (cm *CreateMandate) Validate() error {
+	var required [][2]string
+
+	switch cm.Method {
+	case "":
+		return &ValidationError{Field: "method", Reason: "is required"}
+	case DirectDebit:
+		required = [][2]string{
+			{"consumerName", cm.ConsumerName},
+			{"consumerAccount", cm.ConsumerAccount},
+		}
+	case PayPal:
+		required = [][2]string{
+			{"consumerName", cm.ConsumerName},
+			{"consumerEmail", cm.ConsumerEmail},
+			{"paypalBillingAgreementId", cm.PaypalBillingAgreementID},
+		}
+	}
+
+	for _, f := range required {
+		if f[1] == "" {
+			return &ValidationError{Field: f[0], Reason: fmt.Sprintf("is required for %s mandates", cm.Method)}
+		}
+	}
+
+	return nil
+}
+
 // CreateMandateAccessTokenFields contains the parameters to create a mandate when using an  access token.
 type CreateMandateAccessTokenFields struct {
 	Testmode bool `json:"testmode,omitempty"`
@@ -116,25 +147,35 @@ type MandatesList struct {
 // Create a mandate for a specific customer.
 //
 // Mandates allow you to charge a customer’s credit card or bank account recurrently.
+// The mandate is validated before sending it, see CreateMandate.Validate.
 //
 // See: https://docs.mollie.com/reference/v2/mandates-api/create-mandate
-func (ms *MandatesService) Create(ctx context.Context, customer string, mandate CreateMandate) (
+func (ms *MandatesService) Create(
+	ctx context.Context,
+	customer string,
+	mandate CreateMandate,
+	reqOpts ...RequestOption,
+) (
 	res *Response,
 	mr *Mandate,
 	err error,
 ) {
+	if err = mandate.Validate(); err != nil {
+		return
+	}
+
 	u := fmt.Sprintf("v2/customers/%s/mandates", customer)
 
 	if ms.client.HasAccessToken() && ms.client.config.testing {
 		mandate.Testmode = true
 	}
 
-	res, err = ms.client.post(ctx, u, mandate, nil)
+	res, err = ms.client.post(ctx, u, mandate, nil, reqOpts...)
 	if err != nil {
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &mr); err != nil {
+	if err = res.decode(&mr); err != nil {
 		return
 	}
 
@@ -154,7 +195,7 @@ func (ms *MandatesService) Get(ctx context.Context, customer, mandate string) (r
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &mr); err != nil {
+	if err = res.decode(&mr); err != nil {
 		return
 	}
 
@@ -167,10 +208,14 @@ func (ms *MandatesService) Get(ctx context.Context, customer, mandate string) (r
 // or credit card with this mandate and all connected subscriptions will be canceled.
 //
 // See: https://docs.mollie.com/reference/v2/mandates-api/revoke-mandate
-func (ms *MandatesService) Revoke(ctx context.Context, customer, mandate string) (res *Response, err error) {
+func (ms *MandatesService) Revoke(
+	ctx context.Context,
+	customer, mandate string,
+	reqOpts ...RequestOption,
+) (res *Response, err error) {
 	u := fmt.Sprintf("v2/customers/%s/mandates/%s", customer, mandate)
 
-	res, err = ms.client.delete(ctx, u)
+	res, err = ms.client.delete(ctx, u, reqOpts...)
 	if err != nil {
 		return
 	}
@@ -194,7 +239,7 @@ func (ms *MandatesService) List(ctx context.Context, customer string, options *L
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &ml); err != nil {
+	if err = res.decode(&ml); err != nil {
 		return
 	}
 
@@ -67,7 +67,7 @@ func TestMandatesService_Get(t *testing.T) {
 				"cst_4qqhO89gsT",
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding Mandate: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -137,7 +137,10 @@ func TestMandatesService_Create(t *testing.T) {
 			args{
 				context.Background(),
 				CreateMandate{
-					Method: PayPal,
+					Method:                   PayPal,
+					ConsumerName:             "John Doe",
+					ConsumerEmail:            "john.doe@example.org",
+					PaypalBillingAgreementID: "B-12A34567B8901234CD",
 				},
 				"cst_4qqhO89gsT",
 			},
@@ -159,7 +162,10 @@ func TestMandatesService_Create(t *testing.T) {
 			args{
 				context.Background(),
 				CreateMandate{
-					Method: PayPal,
+					Method:                   PayPal,
+					ConsumerName:             "John Doe",
+					ConsumerEmail:            "john.doe@example.org",
+					PaypalBillingAgreementID: "B-12A34567B8901234CD",
 				},
 				"cst_4qqhO89gsT",
 			},
@@ -181,7 +187,10 @@ func TestMandatesService_Create(t *testing.T) {
 			args{
 				context.Background(),
 				CreateMandate{
-					Method: PayPal,
+					Method:                   PayPal,
+					ConsumerName:             "John Doe",
+					ConsumerEmail:            "john.doe@example.org",
+					PaypalBillingAgreementID: "B-12A34567B8901234CD",
 				},
 				"cst_4qqhO89gsT",
 			},
@@ -195,12 +204,15 @@ func TestMandatesService_Create(t *testing.T) {
 			args{
 				context.Background(),
 				CreateMandate{
-					Method: PayPal,
+					Method:                   PayPal,
+					ConsumerName:             "John Doe",
+					ConsumerEmail:            "john.doe@example.org",
+					PaypalBillingAgreementID: "B-12A34567B8901234CD",
 				},
 				"cst_4qqhO89gsT",
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding Mandate: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -209,7 +221,10 @@ func TestMandatesService_Create(t *testing.T) {
 			args{
 				context.Background(),
 				CreateMandate{
-					Method: PayPal,
+					Method:                   PayPal,
+					ConsumerName:             "John Doe",
+					ConsumerEmail:            "john.doe@example.org",
+					PaypalBillingAgreementID: "B-12A34567B8901234CD",
 				},
 				"cst_4qqhO89gsT",
 			},
@@ -418,7 +433,7 @@ func TestMandatesService_List(t *testing.T) {
 				"cst_4qqhO89gsT",
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding MandatesList: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -463,3 +478,55 @@ func TestMandatesService_List(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateMandate_Validate(t *testing.T) {
+	cases := []struct {
+		name    string
+		mandate CreateMandate
+		err     string
+	}{
+		{
+			"method is required",
+			CreateMandate{},
+			"validation_error: method is required",
+		},
+		{
+			"direct debit requires the consumer account",
+			CreateMandate{
+				Method:       DirectDebit,
+				ConsumerName: "John Doe",
+			},
+			"validation_error: consumerAccount is required for directdebit mandates",
+		},
+		{
+			"paypal requires the billing agreement",
+			CreateMandate{
+				Method:        PayPal,
+				ConsumerName:  "John Doe",
+				ConsumerEmail: "john.doe@example.org",
+			},
+			"validation_error: paypalBillingAgreementId is required for paypal mandates",
+		},
+		{
+			"valid direct debit mandate",
+			CreateMandate{
+				Method:          DirectDebit,
+				ConsumerName:    "John Doe",
+				ConsumerAccount: "NL55INGB0000000000",
+			},
+			"",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := c.mandate.Validate()
+			if c.err == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.EqualError(t, err, c.err)
+				assert.IsType(t, &ValidationError{}, err)
+			}
+		})
+	}
+}
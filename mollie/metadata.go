@@ -0,0 +1,64 @@
+package mollie
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Metadata holds the raw JSON value attached to a resource, Mollie accepts
+// any valid JSON value, e.g. a plain string or an object, and returns it unmodified.
+type Metadata json.RawMessage
+
+// NewMetadata encodes v as JSON and returns it as metadata.
+func NewMetadata(v any) (Metadata, error) {
+	var m Metadata
+
+	if err := m.SetFromJSON(v); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// SetFromJSON replaces the metadata with the JSON encoding of v.
+func (m *Metadata) SetFromJSON(v any) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	*m = Metadata(b)
+
+	return nil
+}
+
+// UnmarshalTo decodes the metadata into v, empty and null metadata
+// leave v untouched.
+func (m Metadata) UnmarshalTo(v any) error {
+	if m.IsEmpty() {
+		return nil
+	}
+
+	return json.Unmarshal(m, v)
+}
+
+// IsEmpty reports whether the metadata is missing or null.
+func (m Metadata) IsEmpty() bool {
+	return len(m) == 0 || bytes.Equal(m, []byte("null"))
+}
+
+// MarshalJSON returns the raw metadata, missing metadata is encoded as null.
+func (m Metadata) MarshalJSON() ([]byte, error) {
+	if len(m) == 0 {
+		return []byte("null"), nil
+	}
+
+	return m, nil
+}
+
+// UnmarshalJSON stores a copy of the raw metadata.
+func (m *Metadata) UnmarshalJSON(data []byte) error {
+	*m = append((*m)[0:0], data...)
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package mollie
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/VictorAvelar/mollie-api-go/v4/testdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type orderMetadata struct {
+	OrderID     string `json:"order_id"`
+	Description string `json:"description"`
+}
+
+func TestMetadata_RoundTrip(t *testing.T) {
+	m, err := NewMetadata(orderMetadata{OrderID: "1337", Description: "Lego cars"})
+	require.NoError(t, err)
+
+	b, err := json.Marshal(&CreatePayment{Description: "Order #1337", Metadata: m})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"description":"Order #1337","metadata":{"order_id":"1337","description":"Lego cars"}}`, string(b))
+
+	var p Payment
+	require.NoError(t, json.Unmarshal(b, &p))
+
+	var got orderMetadata
+	require.NoError(t, p.Metadata.UnmarshalTo(&got))
+	assert.Equal(t, orderMetadata{OrderID: "1337", Description: "Lego cars"}, got)
+}
+
+func TestMetadata_Decode(t *testing.T) {
+	cases := []struct {
+		name    string
+		content string
+		empty   bool
+		want    string
+	}{
+		{"plain string metadata", `{"metadata":"order 1337"}`, false, "order 1337"},
+		{"null metadata", `{"metadata":null}`, true, ""},
+		{"missing metadata", `{}`, true, ""},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var r Refund
+			require.NoError(t, json.Unmarshal([]byte(c.content), &r))
+			assert.Equal(t, c.empty, r.Metadata.IsEmpty())
+
+			var got string
+			require.NoError(t, r.Metadata.UnmarshalTo(&got))
+			assert.Equal(t, c.want, got)
+		})
+	}
+}
+
+func TestMetadata_Order(t *testing.T) {
+	var o Order
+	require.NoError(t, json.Unmarshal([]byte(testdata.CreateOrderResponse), &o))
+
+	var got orderMetadata
+	require.NoError(t, o.Metadata.UnmarshalTo(&got))
+	assert.Equal(t, "1337", got.OrderID)
+}
+
+func TestMetadata_Omitted(t *testing.T) {
+	b, err := json.Marshal(&UpdateCustomer{Name: "Jane"})
+	require.NoError(t, err)
+	assert.NotContains(t, string(b), "metadata")
+}
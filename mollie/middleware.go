@@ -0,0 +1,41 @@
+package mollie
+
+import "net/http"
+
+// Doer sends an HTTP request and returns its response, *http.Client
+// implements it.
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// DoerFunc adapts a function to the Doer interface.
+type DoerFunc func(req *http.Request) (*http.Response, error)
+
+// Do calls f(req).
+func (f DoerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Middleware wraps a Doer, it can alter the request before calling next
+// and inspect the response afterwards, e.g. to add headers or record metrics.
+type Middleware func(next Doer) Doer
+
+// WithMiddleware appends middlewares to the client, they are composed in
+// order so the first one receives the request first and the response last.
+//
+// Middlewares see every attempt of a request, retries included, after the
+// request has been built and authenticated.
+func (c *Client) WithMiddleware(mw ...Middleware) {
+	c.middlewares = append(c.middlewares, mw...)
+}
+
+// doer returns the base http client wrapped by the registered middlewares.
+func (c *Client) doer() Doer {
+	var d Doer = c.client
+
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		d = c.middlewares[i](d)
+	}
+
+	return d
+}
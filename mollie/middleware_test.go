@@ -0,0 +1,59 @@
+package mollie
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/VictorAvelar/mollie-api-go/v4/testdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_WithMiddleware(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	tMux.HandleFunc("/v2/payments/tr_WDqYK6vllg", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "X-Correlation-Id", "corr_123")
+		testHeader(t, r, AuthHeader, "Bearer token_X12b31ggg23")
+		_, _ = w.Write([]byte(testdata.GetPaymentResponse))
+	})
+
+	var calls []string
+
+	trace := func(name string) Middleware {
+		return func(next Doer) Doer {
+			return DoerFunc(func(req *http.Request) (*http.Response, error) {
+				calls = append(calls, name+" request")
+				res, err := next.Do(req)
+				calls = append(calls, name+" response "+res.Status)
+
+				return res, err
+			})
+		}
+	}
+
+	correlation := func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			req.Header.Set("X-Correlation-Id", "corr_123")
+
+			return next.Do(req)
+		})
+	}
+
+	tClient.WithMiddleware(trace("outer"), trace("inner"))
+	tClient.WithMiddleware(correlation)
+
+	_, p, err := tClient.Payments.Get(context.Background(), "tr_WDqYK6vllg", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "tr_WDqYK6vllg", p.ID)
+	assert.Equal(t, []string{
+		"outer request",
+		"inner request",
+		"inner response 200 OK",
+		"outer response 200 OK",
+	}, calls)
+}
@@ -2,20 +2,26 @@ package mollie
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"math"
+	"mime"
 	"net/http"
 	"net/url"
 	"os"
+	"reflect"
 	"regexp"
 	"runtime"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/VictorAvelar/mollie-api-go/v4/pkg/idempotency"
-	"github.com/google/go-querystring/query"
+	"golang.org/x/oauth2"
 )
 
 // Constants holding values for client initialization and request instantiation.
@@ -29,12 +35,16 @@ const (
 	IdempotencyKeyHeader string = "Idempotency-Key"
 	ClientName           string = "MollieGoClient"
 	Version              string = "4.0.0"
+	// MaxIdempotencyKeyLength is the maximum length accepted by Mollie for idempotency keys.
+	MaxIdempotencyKeyLength int = 40
 )
 
 var (
 	accessTokenExpr = regexp.MustCompile(`(?m)^access_`)
+	apiKeyExpr      = regexp.MustCompile(`^(live|test)_`)
 	errEmptyAuthKey = errors.New("you must provide a non-empty authentication key")
 	errBadBaseURL   = errors.New("malformed base url, it must contain a trailing slash")
+	errLongIdemKey  = fmt.Errorf("idempotency keys can not be longer than %d characters", MaxIdempotencyKeyLength)
 
 	goData = strings.Join([]string{runtime.GOOS, runtime.GOARCH, runtime.Version()}, "/")
 )
@@ -49,6 +59,20 @@ type Client struct {
 	config         *Config
 	// Tools
 	idempotencyKeyProvider idempotency.KeyGenerator
+	retry                  *retryPolicy
+	tokenSource            oauth2.TokenSource
+	timeout                time.Duration
+	cache                  Cache
+	logger                 Logger
+	skipProfileScope       bool
+	middlewares            []Middleware
+	observer               RequestObserver
+	breaker                *circuitBreaker
+	lifecycle              lifecycle
+	maxResponseBytes       int64
+	strictDecoding         bool
+	poll                   pollPolicy
+	deriveIdempotencyKeys  bool
 	// Services
 	Payments       *PaymentsService
 	Chargebacks    *ChargebacksService
@@ -79,55 +103,25 @@ type service struct {
 }
 
 func (c *Client) get(ctx context.Context, uri string, options interface{}) (res *Response, err error) {
-	if options != nil {
-		v, _ := query.Values(options)
-		uri = fmt.Sprintf("%s?%s", uri, v.Encode())
-	}
-
-	req, err := c.NewAPIRequest(ctx, http.MethodGet, uri, nil)
-	if err != nil {
-		return
-	}
-
-	return c.Do(req)
+	return c.send(ctx, http.MethodGet, uri, options, nil)
 }
 
-func (c *Client) post(ctx context.Context, uri string, body interface{}, options interface{}) (
+func (c *Client) post(ctx context.Context, uri string, body interface{}, options interface{}, opts ...RequestOption) (
 	res *Response,
 	err error,
 ) {
-	if options != nil {
-		v, _ := query.Values(options)
-		uri = fmt.Sprintf("%s?%s", uri, v.Encode())
-	}
-
-	req, err := c.NewAPIRequest(ctx, http.MethodPost, uri, body)
-	if err != nil {
-		return
-	}
-
-	return c.Do(req)
+	return c.send(ctx, http.MethodPost, uri, options, body, opts...)
 }
 
-func (c *Client) patch(ctx context.Context, uri string, body interface{}) (
+func (c *Client) patch(ctx context.Context, uri string, body interface{}, opts ...RequestOption) (
 	res *Response,
 	err error,
 ) {
-	req, err := c.NewAPIRequest(ctx, http.MethodPatch, uri, body)
-	if err != nil {
-		return
-	}
-
-	return c.Do(req)
+	return c.send(ctx, http.MethodPatch, uri, nil, body, opts...)
 }
 
-func (c *Client) delete(ctx context.Context, uri string) (res *Response, err error) {
-	req, err := c.NewAPIRequest(ctx, http.MethodDelete, uri, nil)
-	if err != nil {
-		return
-	}
-
-	return c.Do(req)
+func (c *Client) delete(ctx context.Context, uri string, opts ...RequestOption) (res *Response, err error) {
+	return c.send(ctx, http.MethodDelete, uri, nil, nil, opts...)
 }
 
 // WithAuthenticationValue offers a convenient setter for any of the valid authentication
@@ -146,6 +140,103 @@ func (c *Client) WithAuthenticationValue(k string) error {
 	return nil
 }
 
+// WithTimeout bounds the duration of the requests whose context has no
+// deadline, so passing context.Background() yields a bounded request.
+// Deadlines set by the caller are always honored, a zero or negative
+// duration disables the default timeout.
+func (c *Client) WithTimeout(d time.Duration) {
+	c.timeout = d
+}
+
+// Ping checks the client credentials by listing the enabled payment methods,
+// it allows failing fast on invalid or expired credentials when the client
+// is created. Credential problems match ErrUnauthorized or ErrForbidden.
+//
+// See: https://docs.mollie.com/reference/v2/methods-api/list-methods
+func (c *Client) Ping(ctx context.Context) error {
+	_, err := c.get(ctx, "v2/methods", nil)
+
+	return err
+}
+
+// Verify confirms the client credentials and the connectivity to Mollie, it is
+// an alias of Ping meant for smoke tests and liveness checks. A nil error is
+// returned on success, credential problems match ErrUnauthorized or ErrForbidden.
+func (c *Client) Verify(ctx context.Context) error {
+	return c.Ping(ctx)
+}
+
+// DefaultMaxResponseBytes is the default size limit of the response bodies,
+// far above the size of any Mollie response.
+const DefaultMaxResponseBytes int64 = 32 << 20
+
+// ErrResponseTooLarge is returned when the response body, once decompressed,
+// exceeds the limit configured using Client.WithMaxResponseBytes.
+var ErrResponseTooLarge = errors.New("response too large")
+
+// WithMaxResponseBytes bounds the size of the response bodies read into memory,
+// the limit applies to the decompressed body and responses exceeding it fail
+// with ErrResponseTooLarge. A zero or negative size restores
+// DefaultMaxResponseBytes.
+func (c *Client) WithMaxResponseBytes(n int64) {
+	c.maxResponseBytes = n
+}
+
+func (c *Client) responseLimit() int64 {
+	if c.maxResponseBytes <= 0 {
+		return DefaultMaxResponseBytes
+	}
+
+	return c.maxResponseBytes
+}
+
+// WithBaseURL changes the URL used as base for all the requests, it is useful
+// to point the client to a mock server.
+//
+// The URL must be absolute and end with a trailing slash, otherwise the
+// resource paths would not be joined correctly.
+func (c *Client) WithBaseURL(u string) error {
+	uri, err := url.Parse(u)
+	if err != nil || !uri.IsAbs() || uri.Host == "" || !strings.HasSuffix(uri.Path, "/") {
+		return errBadBaseURL
+	}
+
+	c.BaseURL = uri
+
+	return nil
+}
+
+// WithUserAgent prepends a descriptive value to the User-Agent sent with
+// every request, the library name and version are always kept.
+// Passing an empty value restores the default User-Agent.
+func (c *Client) WithUserAgent(s string) {
+	c.userAgent = defaultUserAgent()
+
+	if s = strings.TrimSpace(s); s != "" {
+		c.userAgent = strings.Join([]string{s, c.userAgent}, " ")
+	}
+}
+
+func defaultUserAgent() string {
+	return strings.Join([]string{
+		ClientName,
+		Version,
+		goData,
+	}, "/")
+}
+
+// WithTokenSource authenticates every request using the tokens provided by ts,
+// the token is fetched for each request so refreshed tokens are picked up
+// automatically, and it takes precedence over the static authentication value.
+// Wrap ts with oauth2.ReuseTokenSource if it does not cache tokens itself.
+//
+// OAuth tokens do not carry the test/live distinction in the key prefix, so
+// when a token source is configured testmode is not set automatically and
+// must be provided explicitly in the request options or body.
+func (c *Client) WithTokenSource(ts oauth2.TokenSource) {
+	c.tokenSource = ts
+}
+
 // HasAccessToken will return true when the provided authentication token
 // complies with the access token REGEXP match check.
 // This will enable TestMode inside the request body.
@@ -155,16 +246,170 @@ func (c *Client) HasAccessToken() bool {
 	return accessTokenExpr.Match([]byte(c.authentication))
 }
 
+// WithProfileScopeValidation enables or disables the client side checks of the
+// profileId: the filter is rejected when the client authenticates with an API
+// key and payments and orders are only created with a profile id when the
+// client authenticates with an access token.
+//
+// API keys are bound to a single profile, Mollie only accepts the profileId
+// filter from organization or OAuth access tokens and answers with 403 Forbidden
+// otherwise, while access tokens have to tell which profile new payments and
+// orders belong to. The validation is enabled by default.
+func (c *Client) WithProfileScopeValidation(enabled bool) {
+	c.skipProfileScope = !enabled
+}
+
+// WithStrictDecoding enables or disables the strict decoding of the responses,
+// when enabled fields sent by Mollie but not described by the target struct
+// fail the decoding instead of being silently dropped.
+//
+// It is meant for contract tests flagging schema changes early and is disabled
+// by default, so new fields added by Mollie never break production clients.
+// Structs decoding themselves, e.g. links and payment details, are not checked.
+func (c *Client) WithStrictDecoding(enabled bool) {
+	c.strictDecoding = enabled
+}
+
+// inTestMode reports whether the requests are sent in test mode, test API keys
+// always are, live API keys never are and the remaining credentials are when
+// the config or the request enables the test mode.
+func (c *Client) inTestMode(testmode bool) bool {
+	switch {
+	case strings.HasPrefix(c.authentication, "test_"):
+		return true
+	case strings.HasPrefix(c.authentication, "live_"):
+		return false
+	default:
+		return c.config.testing || testmode
+	}
+}
+
+// validateProfileScope returns a *ValidationError when a profile id is
+// provided while authenticating with an API key.
+func (c *Client) validateProfileScope(profileID string) error {
+	if profileID == "" || c.skipProfileScope || c.tokenSource != nil {
+		return nil
+	}
+
+	if apiKeyExpr.MatchString(c.authentication) {
+		return &ValidationError{
+			Field:  "profileId",
+			Reason: "can only be used with organization or OAuth access tokens, API keys are bound to a single profile",
+		}
+	}
+
+	return nil
+}
+
+// validateCreateProfile returns a *ValidationError when creating a resource
+// without profile id using an access token, or with a profile id using an
+// API key, which Mollie would reject.
+func (c *Client) validateCreateProfile(profileID string) error {
+	if c.skipProfileScope {
+		return nil
+	}
+
+	if profileID == "" && (c.HasAccessToken() || c.tokenSource != nil) {
+		return &ValidationError{
+			Field:  "profileId",
+			Reason: "is required when using organization or OAuth access tokens",
+		}
+	}
+
+	return c.validateProfileScope(profileID)
+}
+
 // SetIdempotencyKeyGenerator allows you to pass your own idempotency
 // key generator.
 func (c *Client) SetIdempotencyKeyGenerator(kg idempotency.KeyGenerator) {
 	c.idempotencyKeyProvider = kg
 }
 
+type idempotencyKeyCtx struct{}
+
+// WithIdempotencyKey returns a copy of ctx carrying the idempotency key to be
+// sent with the requests created using it.
+//
+// The key is only attached to POST, PATCH and DELETE requests, and it takes
+// precedence over the keys produced by the configured idempotency.KeyGenerator.
+// Reusing the same key when retrying a request ensures Mollie returns the result
+// of the original operation instead of performing it twice.
+//
+// Mollie accepts keys with up to 40 characters, longer keys are rejected
+// when building the request.
+//
+// See: https://docs.mollie.com/overview/api-idempotency
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyCtx{}, key)
+}
+
+func idempotencyKeyFromContext(ctx context.Context) (string, bool) {
+	key, ok := ctx.Value(idempotencyKeyCtx{}).(string)
+
+	return key, ok && key != ""
+}
+
+type queryParamsCtx struct{}
+
+// WithQueryParams returns a copy of ctx carrying extra query string parameters
+// to be sent with the requests created using it, which allows passing
+// parameters not yet supported by the typed options.
+//
+// Parameters set by the typed options take precedence: an extra parameter is
+// only added when the request query has no value for its key. Calling
+// WithQueryParams on a context already carrying parameters merges them, the
+// values of the latest call replace the previous ones for the same key.
+func WithQueryParams(ctx context.Context, params url.Values) context.Context {
+	merged := url.Values{}
+
+	for k, v := range queryParamsFromContext(ctx) {
+		merged[k] = v
+	}
+
+	for k, v := range params {
+		merged[k] = append([]string(nil), v...)
+	}
+
+	return context.WithValue(ctx, queryParamsCtx{}, merged)
+}
+
+func queryParamsFromContext(ctx context.Context) url.Values {
+	params, _ := ctx.Value(queryParamsCtx{}).(url.Values)
+
+	return params
+}
+
+// mergeQuery adds the extra parameters not already present in the query of u.
+func mergeQuery(u *url.URL, extra url.Values) {
+	if len(extra) == 0 {
+		return
+	}
+
+	qp := u.Query()
+
+	for k, v := range extra {
+		if _, ok := qp[k]; ok {
+			continue
+		}
+
+		qp[k] = v
+	}
+
+	u.RawQuery = qp.Encode()
+}
+
 // NewAPIRequest is a wrapper around the http.NewRequest function.
 //
 // It will setup the authentication headers/parameters according to the client config.
-func (c *Client) NewAPIRequest(ctx context.Context, method string, uri string, body interface{}) (
+// The request options carried by ctx, see WithRequestOptions, and the provided
+// ones are applied last.
+func (c *Client) NewAPIRequest(
+	ctx context.Context,
+	method string,
+	uri string,
+	body interface{},
+	opts ...RequestOption,
+) (
 	req *http.Request,
 	err error,
 ) {
@@ -180,7 +425,7 @@ func (c *Client) NewAPIRequest(ctx context.Context, method string, uri string, b
 
 	if c.config.testing && c.HasAccessToken() {
 		qp := url.Query()
-		qp.Add("testmode", "true")
+		qp.Set("testmode", "true")
 		url.RawQuery = qp.Encode()
 	}
 
@@ -200,6 +445,12 @@ func (c *Client) NewAPIRequest(ctx context.Context, method string, uri string, b
 		ctx = context.Background()
 	}
 
+	if key, ok := idempotencyKeyFromContext(ctx); ok && len(key) > MaxIdempotencyKeyLength {
+		return nil, errLongIdemKey
+	}
+
+	mergeQuery(url, queryParamsFromContext(ctx))
+
 	req, err = http.NewRequestWithContext(ctx, method, url.String(), buf)
 	if err != nil {
 		return nil, fmt.Errorf("new_request: %w", err)
@@ -207,36 +458,173 @@ func (c *Client) NewAPIRequest(ctx context.Context, method string, uri string, b
 
 	c.addRequestHeaders(req)
 
+	if c.deriveIdempotencyKeys && method == http.MethodPost && req.Header.Get(IdempotencyKeyHeader) == "" {
+		key, err := derivedIdempotencyKey(method, url.String(), body)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set(IdempotencyKeyHeader, key)
+	}
+
+	if c.tokenSource != nil {
+		tkn, err := c.tokenSource.Token()
+		if err != nil {
+			return nil, fmt.Errorf("token_source: %w", err)
+		}
+
+		req.Header.Set(AuthHeader, strings.Join([]string{TokenType, tkn.AccessToken}, " "))
+	}
+
+	applyRequestOptions(req, opts)
+
+	if err := checkIdempotencyKey(req); err != nil {
+		return nil, err
+	}
+
 	return req, nil
 }
 
+// checkIdempotencyKey enforces the idempotency key rules on the final request
+// headers, keys set through request options included: keys are limited to
+// MaxIdempotencyKeyLength and only sent along POST, PATCH and DELETE requests.
+func checkIdempotencyKey(req *http.Request) error {
+	key := req.Header.Get(IdempotencyKeyHeader)
+	if key == "" {
+		return nil
+	}
+
+	if len(key) > MaxIdempotencyKeyLength {
+		return errLongIdemKey
+	}
+
+	switch req.Method {
+	case http.MethodPost, http.MethodPatch, http.MethodDelete:
+	default:
+		req.Header.Del(IdempotencyKeyHeader)
+	}
+
+	return nil
+}
+
 func (c *Client) addRequestHeaders(req *http.Request) {
 	req.Header.Add(AuthHeader, strings.Join([]string{TokenType, c.authentication}, " "))
 	req.Header.Set("Content-Type", RequestContentType)
 	req.Header.Set("Accept", RequestContentType)
+	req.Header.Set("Accept-Encoding", "gzip")
 	req.Header.Set("User-Agent", c.userAgent)
 
 	if c.config.reqIdempotency &&
 		c.idempotencyKeyProvider != nil &&
+		!c.deriveIdempotencyKeys &&
 		req.Method == http.MethodPost {
 		req.Header.Set(IdempotencyKeyHeader, c.idempotencyKeyProvider.Generate())
 	}
+
+	if key, ok := idempotencyKeyFromContext(req.Context()); ok {
+		switch req.Method {
+		case http.MethodPost, http.MethodPatch, http.MethodDelete:
+			req.Header.Set(IdempotencyKeyHeader, key)
+		}
+	}
 }
 
 // Do sends an API request and returns the API response or returned as an
 // error if an API error has occurred.
+//
+// When a retry policy is configured, requests failing with a retryable
+// status code are sent again until they succeed or the policy is exhausted.
+//
+// When a timeout is configured and the request context has no deadline,
+// the request, including its retries, is bounded by the timeout.
+//
+// The request of the returned response is always the one provided by the
+// caller, contexts derived for timeouts and Close are internal.
 func (c *Client) Do(req *http.Request) (*Response, error) {
-	resp, err := c.client.Do(req)
+	orig := req
+
+	if _, ok := req.Context().Deadline(); !ok && c.timeout > 0 {
+		ctx, cancel := context.WithTimeout(req.Context(), c.timeout)
+		defer cancel()
+
+		req = req.WithContext(ctx)
+	}
+
+	ctx, cancel := c.bind(req.Context())
+	defer cancel()
+
+	response, err := c.doWithRetry(req.WithContext(ctx))
+	if response != nil && response.Response != nil {
+		response.Request = orig
+	}
+
+	return response, err
+}
+
+func (c *Client) doWithRetry(req *http.Request) (*Response, error) {
+	for attempt := 0; ; attempt++ {
+		if c.isClosed() {
+			return nil, ErrClientClosed
+		}
+
+		if !c.breaker.allow() {
+			return nil, ErrCircuitOpen
+		}
+
+		start := time.Now()
+		response, err := c.do(req)
+		c.observe(req, response, err, attempt, start)
+		c.breaker.record(req, response, err)
+
+		if !c.retry.shouldRetry(req, response, attempt) {
+			return response, err
+		}
+
+		if err := sleep(req.Context(), c.retry.backoff(attempt, response)); err != nil {
+			return response, fmt.Errorf("retry_aborted: %w", err)
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return response, fmt.Errorf("retry_body_error: %w", err)
+			}
+
+			req.Body = body
+		}
+	}
+}
+
+func (c *Client) do(req *http.Request) (*Response, error) {
+	entry := c.cached(req)
+
+	resp, err := c.doer().Do(req)
 	if err != nil {
+		c.log(req, nil, err)
+
 		return nil, fmt.Errorf("http_error: %w", err)
 	}
 	defer resp.Body.Close()
 
-	response, err := newResponse(resp)
+	response, err := newResponse(resp, c.responseLimit())
 	if err != nil {
 		return response, err
 	}
 
+	response.strict = c.strictDecoding
+
+	c.log(req, resp, nil)
+
+	if err := checkContentType(response); err != nil {
+		return response, err
+	}
+
+	c.revalidate(req, response, entry)
+
+	if response.StatusCode == http.StatusNotModified && entry != nil {
+		return response, nil
+	}
+
 	err = CheckResponse(response)
 	if err != nil {
 		return response, err
@@ -247,7 +635,10 @@ func (c *Client) Do(req *http.Request) (*Response, error) {
 
 // NewClient returns a new Mollie HTTP API client.
 // You can pass a previously build http client, if none is provided then
-// http.DefaultClient will be used.
+// the one returned by DefaultHTTPClient will be used. Every request is sent through the provided
+// http client, use it to control timeouts, proxies, transports or TLS settings.
+//
+// A nil Config behaves as NewAPIConfig(false).
 //
 // NewClient will lookup the environment for values to assign to the
 // API token (`MOLLIE_API_TOKEN`) and the Organization token (`MOLLIE_ORG_TOKEN`)
@@ -257,7 +648,11 @@ func (c *Client) Do(req *http.Request) (*Response, error) {
 // WithAPIKey and WithOrganizationKey functions.
 func NewClient(baseClient *http.Client, conf *Config) (mollie *Client, err error) {
 	if baseClient == nil {
-		baseClient = http.DefaultClient
+		baseClient = DefaultHTTPClient()
+	}
+
+	if conf == nil {
+		conf = NewAPIConfig(false)
 	}
 
 	uri, _ := url.Parse(BaseURL)
@@ -267,6 +662,7 @@ func NewClient(baseClient *http.Client, conf *Config) (mollie *Client, err error
 		client:                 baseClient,
 		config:                 conf,
 		idempotencyKeyProvider: nil,
+		lifecycle:              lifecycle{closed: make(chan struct{})},
 	}
 
 	mollie.common.client = mollie
@@ -299,11 +695,7 @@ func NewClient(baseClient *http.Client, conf *Config) (mollie *Client, err error
 	mollie.ClientLinks = (*ClientLinksService)(&mollie.common)
 	mollie.Terminals = (*TerminalsService)(&mollie.common)
 
-	mollie.userAgent = strings.Join([]string{
-		ClientName,
-		Version,
-		goData,
-	}, "/")
+	mollie.userAgent = defaultUserAgent()
 
 	// Parse authorization from specified environment variable
 	tkn, ok := os.LookupEnv(mollie.config.auth)
@@ -320,17 +712,24 @@ Constructor for Error.
 func newError(rsp *Response) error {
 	baseErr := &BaseError{}
 
-	if rsp.ContentLength > 0 {
+	if len(rsp.content) > 0 {
 		err := json.Unmarshal(rsp.content, baseErr)
 		if err != nil {
 			return err
 		}
+
+		if baseErr.Status == 0 {
+			baseErr.Status = rsp.StatusCode
+		}
 	} else {
 		baseErr.Status = rsp.StatusCode
 		baseErr.Title = rsp.Status
 		baseErr.Detail = string(rsp.content)
 	}
 
+	baseErr.Content = rsp.content
+	baseErr.RetryAfter, _ = retryAfter(rsp.Header.Get("Retry-After"))
+
 	return baseErr
 }
 
@@ -340,12 +739,226 @@ func newError(rsp *Response) error {
 type Response struct {
 	*http.Response
 	content []byte
+	strict  bool
+}
+
+// Rate limit headers returned by Mollie.
+const (
+	RateLimitLimitHeader     string = "X-RateLimit-Limit"
+	RateLimitRemainingHeader string = "X-RateLimit-Remaining"
+	RateLimitResetHeader     string = "X-RateLimit-Reset"
+)
+
+// ErrNonJSONResponse is returned when Mollie, or any proxy in between,
+// answers with a body that is not JSON, e.g. an HTML error page.
+var ErrNonJSONResponse = errors.New("non json response")
+
+// ErrEmptyResponse is returned when Mollie answers without content to a
+// request expecting a resource.
+var ErrEmptyResponse = errors.New("empty response: a resource was expected")
+
+// checkContentType verifies non empty responses are JSON encoded.
+//
+// Only bodies explicitly labelled with a non JSON media type, e.g. the
+// HTML pages served by gateways, are rejected. When the content type is
+// missing, or only the text/plain default added by many servers and
+// proxies, the body is left to the decoder: it is accepted as long as it
+// parses as JSON and reported as a decoding error otherwise.
+func checkContentType(r *Response) error {
+	if len(r.content) == 0 {
+		return nil
+	}
+
+	ct := r.Header.Get("Content-Type")
+
+	mt, _, err := mime.ParseMediaType(ct)
+	if err == nil && (mt == "application/json" || strings.HasSuffix(mt, "+json")) {
+		return nil
+	}
+
+	if ct == "" || mt == "text/plain" {
+		return nil
+	}
+
+	return fmt.Errorf(
+		"%w: %s with content type %q (body: %s)",
+		ErrNonJSONResponse, r.Status, ct, redactBody(r.content),
+	)
+}
+
+// readBody reads the response body, gzip encoded bodies are decompressed and
+// the response headers updated to describe the decompressed content.
+func readBody(rsp *http.Response, limit int64) ([]byte, error) {
+	if !strings.EqualFold(rsp.Header.Get("Content-Encoding"), "gzip") {
+		return readLimited(rsp.Body, limit)
+	}
+
+	zr, err := gzip.NewReader(rsp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("gzip_error: %w", err)
+	}
+	defer zr.Close()
+
+	data, err := readLimited(zr, limit)
+	if errors.Is(err, ErrResponseTooLarge) {
+		return nil, err
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("gzip_error: %w", err)
+	}
+
+	rsp.Header.Del("Content-Encoding")
+	rsp.Header.Del("Content-Length")
+	rsp.ContentLength = int64(len(data))
+	rsp.Uncompressed = true
+
+	return data, nil
+}
+
+// readLimited reads r until EOF, failing with ErrResponseTooLarge as soon as
+// more than limit bytes are read, non positive limits read r entirely.
+func readLimited(r io.Reader, limit int64) ([]byte, error) {
+	if limit <= 0 || limit == math.MaxInt64 {
+		return io.ReadAll(r)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(r, limit+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if int64(len(data)) > limit {
+		return nil, fmt.Errorf("%w: more than %d bytes", ErrResponseTooLarge, limit)
+	}
+
+	return data, nil
+}
+
+// decodeBodyLimit bounds the size of the response body included in decoding errors.
+const decodeBodyLimit = 256
+
+var (
+	sensitiveFieldExpr = regexp.MustCompile(`"((?i:[a-z_]*(?:token|secret|password|iban|account|cardNumber)[a-z_]*))"\s*:\s*"[^"]*"`)
+	credentialExpr     = regexp.MustCompile(`\b(live|test|access)_[A-Za-z0-9]{10,}`)
+)
+
+// decode unmarshals the response content into v, decoding errors mention
+// the expected resource and include a truncated and redacted copy of the body.
+//
+// A resource is expected, empty responses fail with ErrEmptyResponse, see
+// decodeOptional for the methods where Mollie may answer without content.
+func (r *Response) decode(v any) error {
+	if r.empty() {
+		return fmt.Errorf("mollie: decoding %s: %w", resourceName(v), ErrEmptyResponse)
+	}
+
+	err := r.unmarshal(v)
+	if err == nil {
+		return nil
+	}
+
+	return fmt.Errorf("mollie: decoding %s: %w (body: %s)", resourceName(v), err, redactBody(r.content))
+}
+
+// decodeOptional decodes the response content into v like decode, except
+// empty responses, like the 204 No Content returned when deleting or
+// cancelling resources, are successful and leave v untouched.
+func (r *Response) decodeOptional(v any) error {
+	if r.empty() {
+		return nil
+	}
+
+	return r.decode(v)
+}
+
+// empty reports whether the response has no content to decode, either
+// because of its status or because the body is blank.
+func (r *Response) empty() bool {
+	if r.Response != nil && r.StatusCode == http.StatusNoContent {
+		return true
+	}
+
+	return len(bytes.TrimSpace(r.content)) == 0
+}
+
+// unmarshal decodes the content into v, rejecting unknown fields in strict mode.
+func (r *Response) unmarshal(v any) error {
+	if !r.strict {
+		return json.Unmarshal(r.content, v)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(r.content))
+	dec.DisallowUnknownFields()
+
+	return dec.Decode(v)
+}
+
+// resourceName returns the name of the type v points to.
+func resourceName(v any) string {
+	t := reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if t == nil || t.Name() == "" {
+		return "response"
+	}
+
+	return t.Name()
+}
+
+// redactBody masks the credentials and sensitive fields found in
+// the body and truncates it to decodeBodyLimit bytes.
+func redactBody(b []byte) string {
+	s := sensitiveFieldExpr.ReplaceAllString(string(b), `"$1":"`+redacted+`"`)
+	s = credentialExpr.ReplaceAllString(s, redacted)
+
+	if len(s) > decodeBodyLimit {
+		s = s[:decodeBodyLimit] + "..."
+	}
+
+	return s
+}
+
+// RateLimit describes the request quota reported by Mollie for a response.
+//
+// Limit and Remaining are -1 when the header is not present, Reset is the
+// zero time when unknown.
+type RateLimit struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time
+}
+
+// RateLimit returns the rate limit information sent with the response,
+// callers can use it after a List call to pace the following requests.
+func (r *Response) RateLimit() RateLimit {
+	rl := RateLimit{Limit: -1, Remaining: -1}
+
+	if r == nil || r.Response == nil {
+		return rl
+	}
+
+	if v, err := strconv.Atoi(r.Header.Get(RateLimitLimitHeader)); err == nil {
+		rl.Limit = v
+	}
+
+	if v, err := strconv.Atoi(r.Header.Get(RateLimitRemainingHeader)); err == nil {
+		rl.Remaining = v
+	}
+
+	if v, err := strconv.ParseInt(r.Header.Get(RateLimitResetHeader), 10, 64); err == nil {
+		rl.Reset = time.Unix(v, 0)
+	}
+
+	return rl
 }
 
-func newResponse(rsp *http.Response) (*Response, error) {
+func newResponse(rsp *http.Response, limit int64) (*Response, error) {
 	res := Response{Response: rsp}
 
-	data, err := io.ReadAll(rsp.Body)
+	data, err := readBody(rsp, limit)
 	if err != nil {
 		return &res, err
 	}
@@ -1,13 +1,18 @@
 package mollie
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -16,6 +21,7 @@ import (
 	"github.com/VictorAvelar/mollie-api-go/v4/testdata"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
 )
 
 func TestNewClient(t *testing.T) {
@@ -48,6 +54,37 @@ func TestNewClient(t *testing.T) {
 	}
 }
 
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestNewClient_CustomHTTPClient(t *testing.T) {
+	var calls int
+
+	hc := &http.Client{
+		Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			calls++
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader("{}")),
+				Header:     http.Header{},
+				Request:    r,
+			}, nil
+		}),
+	}
+
+	c, err := NewClient(hc, nil)
+	require.Nil(t, err)
+	require.Nil(t, c.WithAuthenticationValue("test_token"))
+
+	_, _, err = c.Payments.Get(context.Background(), "tr_WDqYK6vllg", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, calls)
+}
+
 func TestNewClientWithEnvVars(t *testing.T) {
 	setEnv()
 	setup()
@@ -230,6 +267,142 @@ func TestClient_NewApiRequest_IdempotencyKeys(t *testing.T) {
 	}
 }
 
+func TestClient_NewAPIRequest_ContextIdempotencyKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		method  string
+		key     string
+		want    string
+		wantErr bool
+	}{
+		{
+			"context key is used for post requests",
+			http.MethodPost,
+			"order_12345_payment",
+			"order_12345_payment",
+			false,
+		},
+		{
+			"context key is used for patch requests",
+			http.MethodPatch,
+			"order_12345_update",
+			"order_12345_update",
+			false,
+		},
+		{
+			"context key is used for delete requests",
+			http.MethodDelete,
+			"order_12345_cancel",
+			"order_12345_cancel",
+			false,
+		},
+		{
+			"context key is ignored for get requests",
+			http.MethodGet,
+			"order_12345_get",
+			"",
+			false,
+		},
+		{
+			"context key longer than the allowed length is rejected",
+			http.MethodPost,
+			strings.Repeat("k", MaxIdempotencyKeyLength+1),
+			"",
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setEnv()
+			setup()
+			defer teardown()
+			defer unsetEnv()
+
+			ctx := WithIdempotencyKey(context.Background(), tt.key)
+
+			req, err := tClient.NewAPIRequest(ctx, tt.method, "test", nil)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, errLongIdemKey)
+
+				return
+			}
+
+			assert.Nil(t, err)
+			testHeader(t, req, IdempotencyKeyHeader, tt.want)
+		})
+	}
+}
+
+func TestClient_NewAPIRequest_ContextQueryParams(t *testing.T) {
+	tests := []struct {
+		name   string
+		uri    string
+		params []url.Values
+		want   string
+	}{
+		{
+			"no extra params keep the query untouched",
+			"v2/payments?include=details.qrCode",
+			nil,
+			"include=details.qrCode",
+		},
+		{
+			"extra params are added to the query",
+			"v2/payments?limit=10",
+			[]url.Values{{"include": {"foo"}}},
+			"include=foo&limit=10",
+		},
+		{
+			"typed options take precedence on conflicts",
+			"v2/payments?include=details.qrCode",
+			[]url.Values{{"include": {"foo"}, "bar": {"baz"}}},
+			"bar=baz&include=details.qrCode",
+		},
+		{
+			"later params replace earlier ones for the same key",
+			"v2/payments",
+			[]url.Values{{"include": {"foo"}, "bar": {"baz"}}, {"include": {"qux"}}},
+			"bar=baz&include=qux",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setEnv()
+			setup()
+			defer teardown()
+			defer unsetEnv()
+
+			ctx := context.Background()
+			for _, p := range tt.params {
+				ctx = WithQueryParams(ctx, p)
+			}
+
+			req, err := tClient.NewAPIRequest(ctx, http.MethodGet, tt.uri, nil)
+			require.Nil(t, err)
+			assert.Equal(t, tt.want, req.URL.RawQuery)
+		})
+	}
+}
+
+func TestClient_ContextQueryParams(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	tMux.HandleFunc("/v2/payments/tr_WDqYK6vllg", func(w http.ResponseWriter, r *http.Request) {
+		testQuery(t, r, "future=value&include=details.qrCode")
+		_, _ = w.Write([]byte(testdata.GetPaymentResponse))
+	})
+
+	ctx := WithQueryParams(context.Background(), url.Values{"future": {"value"}, "include": {"foo"}})
+
+	_, _, err := tClient.Payments.Get(ctx, "tr_WDqYK6vllg", &PaymentOptions{Include: []IncludeValue{IncludeQrCode}})
+	assert.Nil(t, err)
+}
+
 func TestClient_NewAPIRequest_ForceErrors(t *testing.T) {
 	type args struct {
 		ctx    context.Context
@@ -361,6 +534,164 @@ func TestClient_IsAccessToken(t *testing.T) {
 	}
 }
 
+type tokenSourceFunc func() (*oauth2.Token, error)
+
+func (f tokenSourceFunc) Token() (*oauth2.Token, error) {
+	return f()
+}
+
+func TestClient_WithTokenSource(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	var n int
+
+	tClient.WithTokenSource(tokenSourceFunc(func() (*oauth2.Token, error) {
+		n++
+
+		return &oauth2.Token{AccessToken: fmt.Sprintf("access_refreshed_%d", n)}, nil
+	}))
+
+	for i := 1; i <= 2; i++ {
+		req, err := tClient.NewAPIRequest(context.TODO(), http.MethodGet, "test", nil)
+		require.Nil(t, err)
+		testHeader(t, req, AuthHeader, fmt.Sprintf("Bearer access_refreshed_%d", i))
+		assert.Empty(t, req.URL.Query().Get("testmode"))
+	}
+
+	tClient.WithTokenSource(tokenSourceFunc(func() (*oauth2.Token, error) {
+		return nil, errors.New("refresh failed")
+	}))
+
+	_, err := tClient.NewAPIRequest(context.TODO(), http.MethodGet, "test", nil)
+	assert.EqualError(t, err, "token_source: refresh failed")
+
+	tClient.WithTokenSource(nil)
+
+	req, err := tClient.NewAPIRequest(context.TODO(), http.MethodGet, "test", nil)
+	require.Nil(t, err)
+	testHeader(t, req, AuthHeader, "Bearer token_X12b31ggg23")
+}
+
+func TestClient_WithBaseURL(t *testing.T) {
+	cases := []struct {
+		name string
+		url  string
+		err  error
+	}{
+		{"absolute urls with trailing slash are accepted", "http://localhost:8080/mollie/", nil},
+		{"urls without trailing slash are rejected", "http://localhost:8080/mollie", errBadBaseURL},
+		{"relative urls are rejected", "/mollie/", errBadBaseURL},
+		{"malformed urls are rejected", "http://[::1/", errBadBaseURL},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			client, _ := NewClient(nil, NewAPIConfig(false))
+
+			err := client.WithBaseURL(c.url)
+			assert.Equal(t, c.err, err)
+
+			if c.err != nil {
+				assert.Equal(t, BaseURL, client.BaseURL.String())
+				return
+			}
+
+			req, err := client.NewAPIRequest(context.TODO(), http.MethodGet, "v2/payments", nil)
+			require.Nil(t, err)
+			assert.Equal(t, "http://localhost:8080/mollie/v2/payments", req.URL.String())
+		})
+	}
+}
+
+func TestClient_WithUserAgent(t *testing.T) {
+	client, _ := NewClient(nil, NewAPIConfig(false))
+	def := client.userAgent
+
+	assert.True(t, strings.HasPrefix(def, ClientName+"/"+Version))
+
+	client.WithUserAgent("my-app/1.0")
+	req, err := client.NewAPIRequest(context.TODO(), http.MethodGet, "v2/payments", nil)
+	require.Nil(t, err)
+	testHeader(t, req, "User-Agent", "my-app/1.0 "+def)
+
+	client.WithUserAgent("")
+	assert.Equal(t, def, client.userAgent)
+}
+
+func TestResponse_RateLimit(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	tMux.HandleFunc("/v2/chargebacks", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(RateLimitLimitHeader, "100")
+		w.Header().Set(RateLimitRemainingHeader, "42")
+		w.Header().Set(RateLimitResetHeader, "1700000000")
+		_, _ = w.Write([]byte(testdata.ListChargebacksResponse))
+	})
+
+	res, cl, err := tClient.Chargebacks.List(context.Background(), nil)
+	require.Nil(t, err)
+	assert.NotNil(t, cl)
+
+	rl := res.RateLimit()
+	assert.Equal(t, 100, rl.Limit)
+	assert.Equal(t, 42, rl.Remaining)
+	assert.Equal(t, time.Unix(1700000000, 0), rl.Reset)
+
+	missing := (&Response{Response: &http.Response{Header: http.Header{}}}).RateLimit()
+	assert.Equal(t, RateLimit{Limit: -1, Remaining: -1}, missing)
+}
+
+func TestClient_WithTimeout(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+
+	shortCtx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	cases := []struct {
+		name    string
+		ctx     context.Context
+		timeout time.Duration
+		wantErr bool
+	}{
+		{"requests without deadline are bounded by the timeout", context.Background(), 20 * time.Millisecond, true},
+		{"shorter caller deadlines are honored", shortCtx, time.Minute, true},
+		{"requests are not bounded without timeout", context.Background(), 0, false},
+	}
+
+	for _, c := range cases {
+		setup()
+		defer teardown()
+
+		t.Run(c.name, func(t *testing.T) {
+			tMux.HandleFunc("/v2/payments/tr_WDqYK6vllg", func(w http.ResponseWriter, r *http.Request) {
+				select {
+				case <-r.Context().Done():
+				case <-time.After(200 * time.Millisecond):
+				}
+				_, _ = w.Write([]byte(testdata.GetPaymentResponse))
+			})
+
+			tClient.WithTimeout(c.timeout)
+
+			start := time.Now()
+			_, _, err := tClient.Payments.Get(c.ctx, "tr_WDqYK6vllg", nil)
+			if c.wantErr {
+				assert.ErrorIs(t, err, context.DeadlineExceeded)
+				assert.Less(t, time.Since(start), 200*time.Millisecond)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
 func TestClient_NewAPIRequest_OrgTokenOverApiKey(t *testing.T) {
 	setup()
 	defer teardown()
@@ -572,7 +903,7 @@ func TestClient_newResponse(t *testing.T) {
 
 	for _, c := range cases {
 		t.Run(c.name, func(t *testing.T) {
-			got, err := newResponse(c.args.res)
+			got, err := newResponse(c.args.res, DefaultMaxResponseBytes)
 			if c.wantErr {
 				assert.EqualError(t, err, c.err.Error())
 			} else {
@@ -598,7 +929,7 @@ func TestClient_newError(t *testing.T) {
 		{
 			"new error fails on read operation",
 			args{
-				&Response{Response: &http.Response{Body: closedReader(), ContentLength: 1}},
+				&Response{Response: &http.Response{Body: closedReader()}, content: []byte("{")},
 			},
 			&BaseError{},
 			true,
@@ -716,3 +1047,296 @@ func closedReader() io.ReadCloser {
 }
 
 // <----- .Testing helpers ----->
+
+func TestResponse_decode(t *testing.T) {
+	cases := []struct {
+		name    string
+		content string
+		err     string
+	}{
+		{
+			"errors mention the resource and the body",
+			`{"resource": "chargeback", "id": [}`,
+			`mollie: decoding Chargeback: invalid character '}' looking for beginning of value (body: {"resource": "chargeback", "id": [})`,
+		},
+		{
+			"sensitive fields are redacted",
+			`{"access_token": "access_46EUJ6x8jFJZZeAvhNH4JVey6qVpqR", "consumerAccount": "NL53INGB0654422370", "id": [}`,
+			`mollie: decoding Chargeback: invalid character '}' looking for beginning of value (body: {"access_token":"[REDACTED]", "consumerAccount":"[REDACTED]", "id": [})`,
+		},
+		{
+			"credentials are redacted",
+			`{"description": "live_dHar4XY7LxsDOtmnkVtjNVWXLSlXsM", "id": [}`,
+			`mollie: decoding Chargeback: invalid character '}' looking for beginning of value (body: {"description": "[REDACTED]", "id": [})`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var cb *Chargeback
+
+			err := (&Response{content: []byte(c.content)}).decode(&cb)
+			assert.EqualError(t, err, c.err)
+		})
+	}
+}
+
+func TestResponse_decode_Empty(t *testing.T) {
+	cases := []struct {
+		name   string
+		status int
+		body   string
+	}{
+		{"no content responses are not decoded", http.StatusNoContent, ""},
+		{"empty ok responses are not decoded", http.StatusOK, ""},
+		{"blank ok responses are not decoded", http.StatusOK, " \n"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			setEnv()
+			defer unsetEnv()
+			setup()
+			defer teardown()
+
+			tMux.HandleFunc("/v2/payments/tr_WDqYK6vllg", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "DELETE")
+				w.WriteHeader(c.status)
+				_, _ = w.Write([]byte(c.body))
+			})
+
+			res, p, err := tClient.Payments.Cancel(context.Background(), "tr_WDqYK6vllg")
+			require.Nil(t, err)
+			assert.Nil(t, p)
+			assert.Equal(t, c.status, res.StatusCode)
+		})
+	}
+}
+
+func TestResponse_decode_EmptyResource(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	tMux.HandleFunc("/v2/payments/tr_WDqYK6vllg", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusOK)
+	})
+
+	_, p, err := tClient.Payments.Get(context.Background(), "tr_WDqYK6vllg", nil)
+	assert.ErrorIs(t, err, ErrEmptyResponse)
+	assert.Nil(t, p)
+}
+
+func TestClient_WithStrictDecoding(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+
+	cases := []struct {
+		name    string
+		strict  bool
+		content string
+		err     string
+	}{
+		{"unknown fields are dropped by default", false, `{"id": "chb_n9z0tp", "newField": true}`, ""},
+		{"known fields are decoded in strict mode", true, `{"id": "chb_n9z0tp", "paymentId": "tr_WDqYK6vllg"}`, ""},
+		{
+			"unknown fields are rejected in strict mode",
+			true,
+			`{"id": "chb_n9z0tp", "newField": true}`,
+			`mollie: decoding Chargeback: json: unknown field "newField"`,
+		},
+	}
+
+	for _, c := range cases {
+		setup()
+		defer teardown()
+
+		t.Run(c.name, func(t *testing.T) {
+			tMux.HandleFunc("/v2/payments/tr_WDqYK6vllg/chargebacks/chb_n9z0tp", func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(c.content))
+			})
+
+			tClient.WithStrictDecoding(c.strict)
+
+			_, cb, err := tClient.Chargebacks.Get(context.Background(), "tr_WDqYK6vllg", "chb_n9z0tp", nil)
+			if c.err != "" {
+				assert.ErrorContains(t, err, c.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "chb_n9z0tp", cb.ID)
+		})
+	}
+}
+
+func TestResponse_decode_Truncate(t *testing.T) {
+	content := `{"description": "` + strings.Repeat("a", 2*decodeBodyLimit) + `", "id": [}`
+
+	var cb *Chargeback
+
+	err := (&Response{content: []byte(content)}).decode(&cb)
+
+	var syntaxErr *json.SyntaxError
+	assert.ErrorAs(t, err, &syntaxErr)
+	assert.True(t, strings.HasSuffix(err.Error(), `aaa...)`))
+	assert.Less(t, len(err.Error()), decodeBodyLimit+128)
+}
+
+func TestClient_GzipResponses(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+
+	var gz bytes.Buffer
+
+	zw := gzip.NewWriter(&gz)
+	_, _ = zw.Write([]byte(testdata.ListChargebacksResponse))
+	require.NoError(t, zw.Close())
+
+	cases := []struct {
+		name    string
+		body    []byte
+		wantErr bool
+	}{
+		{"gzip encoded bodies are decompressed", gz.Bytes(), false},
+		{"corrupted gzip bodies are reported", []byte("not gzip"), true},
+	}
+
+	for _, c := range cases {
+		setup()
+		defer teardown()
+
+		t.Run(c.name, func(t *testing.T) {
+			tMux.HandleFunc("/v2/chargebacks", func(w http.ResponseWriter, r *http.Request) {
+				testHeader(t, r, "Accept-Encoding", "gzip")
+				w.Header().Set("Content-Encoding", "gzip")
+				_, _ = w.Write(c.body)
+			})
+
+			res, cl, err := tClient.Chargebacks.List(context.Background(), nil)
+			if c.wantErr {
+				assert.ErrorContains(t, err, "gzip_error")
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, 3, cl.Count)
+			assert.Equal(t, testdata.ListChargebacksResponse, string(res.content))
+			assert.Equal(t, int64(len(res.content)), res.ContentLength)
+			assert.Empty(t, res.Header.Get("Content-Encoding"))
+
+			body, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+			assert.Equal(t, res.content, body)
+		})
+	}
+}
+
+func TestClient_WithMaxResponseBytes(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+
+	var gz bytes.Buffer
+
+	zw := gzip.NewWriter(&gz)
+	_, _ = zw.Write([]byte(testdata.GetPaymentResponse))
+	require.NoError(t, zw.Close())
+
+	size := int64(len(testdata.GetPaymentResponse))
+
+	cases := []struct {
+		name    string
+		limit   int64
+		gzipped bool
+		wantErr bool
+	}{
+		{"bodies within the limit are read", size, false, false},
+		{"bodies above the limit are rejected", size - 1, false, true},
+		{"decompressed bodies above the limit are rejected", size - 1, true, true},
+		{"non positive limits restore the default", -1, true, false},
+	}
+
+	for _, c := range cases {
+		setup()
+		defer teardown()
+
+		t.Run(c.name, func(t *testing.T) {
+			tMux.HandleFunc("/v2/payments/tr_WDqYK6vllg", func(w http.ResponseWriter, r *http.Request) {
+				if c.gzipped {
+					w.Header().Set("Content-Encoding", "gzip")
+					_, _ = w.Write(gz.Bytes())
+
+					return
+				}
+
+				_, _ = w.Write([]byte(testdata.GetPaymentResponse))
+			})
+
+			tClient.WithMaxResponseBytes(c.limit)
+
+			_, p, err := tClient.Payments.Get(context.Background(), "tr_WDqYK6vllg", nil)
+			if c.wantErr {
+				assert.ErrorIs(t, err, ErrResponseTooLarge)
+				assert.NotContains(t, err.Error(), "gzip_error")
+				assert.Nil(t, p)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "tr_WDqYK6vllg", p.ID)
+		})
+	}
+}
+
+func TestClient_NonJSONResponses(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+
+	cases := []struct {
+		name        string
+		status      int
+		contentType string
+		body        string
+		wantErr     bool
+	}{
+		{"hal json responses are accepted", http.StatusOK, "application/hal+json", testdata.GetPaymentResponse, false},
+		{"json responses are accepted", http.StatusOK, "application/json; charset=utf-8", testdata.GetPaymentResponse, false},
+		{"html gateway errors are rejected", http.StatusBadGateway, "text/html", "<html><body>502 Bad Gateway</body></html>", true},
+		{"html pages are rejected", http.StatusOK, "text/html; charset=utf-8", "<html></html>", true},
+		{"missing content types are accepted for json bodies", http.StatusOK, "", testdata.GetPaymentResponse, false},
+		{"plain text json bodies are accepted", http.StatusOK, "text/plain; charset=utf-8", "{}", false},
+		{"html json bodies are rejected", http.StatusOK, "text/html", "{}", true},
+	}
+
+	for _, c := range cases {
+		setup()
+		defer teardown()
+
+		t.Run(c.name, func(t *testing.T) {
+			tMux.HandleFunc("/v2/payments/tr_WDqYK6vllg", func(w http.ResponseWriter, r *http.Request) {
+				w.Header()["Content-Type"] = []string{c.contentType}
+				w.WriteHeader(c.status)
+				_, _ = w.Write([]byte(c.body))
+			})
+
+			_, _, err := tClient.Payments.Get(context.Background(), "tr_WDqYK6vllg", nil)
+			if !c.wantErr {
+				assert.Nil(t, err)
+
+				return
+			}
+
+			assert.ErrorIs(t, err, ErrNonJSONResponse)
+			assert.Contains(t, err.Error(), strconv.Itoa(c.status))
+			assert.Contains(t, err.Error(), c.body)
+
+			var apiErr *BaseError
+			assert.False(t, errors.As(err, &apiErr))
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package mollie
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Nullable is a value of an update request that can be explicitly cleared.
+//
+// Update request fields of type *Nullable[T] have three states:
+//   - nil: the field is omitted and Mollie leaves the current value untouched.
+//   - Null[T](): the field is sent as null and Mollie clears the current value.
+//   - NewNullable(v): the field is sent with v as its new value.
+//
+// The webhook URLs of payments, orders and subscriptions are nullable, metadata
+// is cleared using NullMetadata. The other update fields are plain values
+// whose empty values are omitted, leaving the current values untouched.
+type Nullable[T any] struct {
+	value T
+	null  bool
+}
+
+// NewNullable returns a nullable holding v.
+func NewNullable[T any](v T) *Nullable[T] {
+	return &Nullable[T]{value: v}
+}
+
+// Null returns a nullable encoded as JSON null, used to clear a field.
+func Null[T any]() *Nullable[T] {
+	return &Nullable[T]{null: true}
+}
+
+// NullMetadata returns metadata encoded as JSON null, used to clear the
+// metadata of a resource, empty metadata is omitted from update requests.
+func NullMetadata() Metadata {
+	return Metadata("null")
+}
+
+// Value returns the held value and false when the nullable is null or nil.
+func (n *Nullable[T]) Value() (v T, ok bool) {
+	if n == nil || n.null {
+		return v, false
+	}
+
+	return n.value, true
+}
+
+// IsNull reports whether the nullable is encoded as JSON null.
+func (n *Nullable[T]) IsNull() bool {
+	return n != nil && n.null
+}
+
+// MarshalJSON encodes the held value or null.
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	if n.null {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(n.value)
+}
+
+// UnmarshalJSON decodes the value, JSON null marks the nullable as null.
+func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		var zero T
+
+		n.value, n.null = zero, true
+
+		return nil
+	}
+
+	n.null = false
+
+	return json.Unmarshal(data, &n.value)
+}
@@ -0,0 +1,76 @@
+package mollie
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNullable_MarshalJSON(t *testing.T) {
+	cases := []struct {
+		name   string
+		update any
+		want   string
+	}{
+		{
+			"nil fields are omitted",
+			UpdatePayment{Description: "Order #12345"},
+			`{"description":"Order #12345"}`,
+		},
+		{
+			"null fields are sent as null",
+			UpdatePayment{WebhookURL: Null[string](), Metadata: NullMetadata()},
+			`{"webhookUrl":null,"metadata":null}`,
+		},
+		{
+			"values are sent",
+			UpdatePayment{WebhookURL: NewNullable("https://example.org/webhook")},
+			`{"webhookUrl":"https://example.org/webhook"}`,
+		},
+		{
+			"empty values are sent",
+			UpdatePayment{WebhookURL: NewNullable("")},
+			`{"webhookUrl":""}`,
+		},
+		{
+			"subscription webhooks can be cleared",
+			UpdateSubscription{Description: "Monthly plan", WebhookURL: Null[string]()},
+			`{"description":"Monthly plan","webhookUrl":null}`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			b, err := json.Marshal(c.update)
+			require.NoError(t, err)
+			assert.JSONEq(t, c.want, string(b))
+		})
+	}
+}
+
+func TestNullable_UnmarshalJSON(t *testing.T) {
+	var n Nullable[string]
+
+	require.NoError(t, json.Unmarshal([]byte(`null`), &n))
+	assert.True(t, n.IsNull())
+
+	_, ok := n.Value()
+	assert.False(t, ok)
+
+	var up UpdateOrder
+
+	require.NoError(t, json.Unmarshal([]byte(`{"webhookUrl":"https://example.org/webhook"}`), &up))
+	assert.False(t, up.WebhookURL.IsNull())
+
+	v, ok := up.WebhookURL.Value()
+	assert.True(t, ok)
+	assert.Equal(t, "https://example.org/webhook", v)
+
+	var missing *Nullable[string]
+	assert.False(t, missing.IsNull())
+
+	_, ok = missing.Value()
+	assert.False(t, ok)
+}
@@ -0,0 +1,63 @@
+package mollie
+
+import (
+	"net/http"
+	"time"
+)
+
+// RequestEvent describes a single attempt of a request sent to Mollie.
+type RequestEvent struct {
+	Method     string
+	Path       string
+	StatusCode int
+	Duration   time.Duration
+	Attempt    int
+	Retry      bool
+	Err        error
+}
+
+// RequestObserver is notified about every request attempt sent by the client,
+// it allows to collect metrics without depending on a specific backend.
+//
+// Implementations must be safe for concurrent use and should return quickly,
+// they are called synchronously from the goroutine sending the request.
+type RequestObserver interface {
+	// RequestCompleted is called after each attempt, StatusCode is 0 when
+	// the attempt failed without receiving a response.
+	RequestCompleted(e RequestEvent)
+	// RateLimited is also called when Mollie answered the attempt with
+	// 429 Too Many Requests.
+	RateLimited(e RequestEvent)
+}
+
+// WithObserver registers an observer notified about every request attempt.
+// Passing nil removes the current observer.
+func (c *Client) WithObserver(o RequestObserver) {
+	c.observer = o
+}
+
+// observe notifies the registered observer about a request attempt.
+func (c *Client) observe(req *http.Request, res *Response, err error, attempt int, start time.Time) {
+	if c.observer == nil {
+		return
+	}
+
+	e := RequestEvent{
+		Method:   req.Method,
+		Path:     req.URL.Path,
+		Duration: time.Since(start),
+		Attempt:  attempt,
+		Retry:    attempt > 0,
+		Err:      err,
+	}
+
+	if res != nil && res.Response != nil {
+		e.StatusCode = res.StatusCode
+	}
+
+	c.observer.RequestCompleted(e)
+
+	if e.StatusCode == http.StatusTooManyRequests {
+		c.observer.RateLimited(e)
+	}
+}
@@ -0,0 +1,101 @@
+package mollie
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/VictorAvelar/mollie-api-go/v4/testdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingObserver struct {
+	mu          sync.Mutex
+	completed   []RequestEvent
+	rateLimited []RequestEvent
+}
+
+func (ro *recordingObserver) RequestCompleted(e RequestEvent) {
+	ro.mu.Lock()
+	defer ro.mu.Unlock()
+
+	ro.completed = append(ro.completed, e)
+}
+
+func (ro *recordingObserver) RateLimited(e RequestEvent) {
+	ro.mu.Lock()
+	defer ro.mu.Unlock()
+
+	ro.rateLimited = append(ro.rateLimited, e)
+}
+
+func TestClient_WithObserver(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	var calls int
+
+	tMux.HandleFunc("/v2/payments/tr_WDqYK6vllg", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"status":429,"title":"Too Many Requests","detail":"slow down"}`))
+
+			return
+		}
+
+		_, _ = w.Write([]byte(testdata.GetPaymentResponse))
+	})
+
+	obs := &recordingObserver{}
+
+	tClient.WithRetryPolicy(1, time.Millisecond)
+	tClient.WithObserver(obs)
+
+	_, _, err := tClient.Payments.Get(context.Background(), "tr_WDqYK6vllg", nil)
+	require.NoError(t, err)
+
+	require.Len(t, obs.completed, 2)
+	require.Len(t, obs.rateLimited, 1)
+
+	first, second := obs.completed[0], obs.completed[1]
+
+	assert.Equal(t, http.MethodGet, first.Method)
+	assert.Equal(t, "/v2/payments/tr_WDqYK6vllg", first.Path)
+	assert.Equal(t, http.StatusTooManyRequests, first.StatusCode)
+	assert.False(t, first.Retry)
+	assert.Error(t, first.Err)
+	assert.Equal(t, first, obs.rateLimited[0])
+
+	assert.Equal(t, http.StatusOK, second.StatusCode)
+	assert.Equal(t, 1, second.Attempt)
+	assert.True(t, second.Retry)
+	assert.Nil(t, second.Err)
+	assert.Positive(t, second.Duration)
+}
+
+func TestClient_WithObserver_TransportErrors(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	obs := &recordingObserver{}
+
+	tClient.WithObserver(obs)
+	tClient.BaseURL, _ = tClient.BaseURL.Parse("http://127.0.0.1:0/")
+
+	_, _, err := tClient.Payments.Get(context.Background(), "tr_WDqYK6vllg", nil)
+	require.Error(t, err)
+
+	require.Len(t, obs.completed, 1)
+	assert.Equal(t, 0, obs.completed[0].StatusCode)
+	assert.Error(t, obs.completed[0].Err)
+	assert.Empty(t, obs.rateLimited)
+}
@@ -2,7 +2,6 @@ package mollie
 
 import (
 	"context"
-	"encoding/json"
 	"time"
 )
 
@@ -30,7 +29,7 @@ type OnboardingLinks struct {
 type Onboarding struct {
 	CanReceivePayments    bool             `json:"canReceivePayments,omitempty"`
 	CanReceiveSettlements bool             `json:"canReceiveSettlements,omitempty"`
-	Resource              string           `json:"reference,omitempty"`
+	Resource              string           `json:"resource,omitempty"`
 	Name                  string           `json:"name,omitempty"`
 	SignedUpAt            *time.Time       `json:"signedUpAt,omitempty"`
 	Status                OnboardingStatus `json:"status,omitempty"`
@@ -61,6 +60,7 @@ type OnboardingDataProfile struct {
 	Description      string           `json:"description,omitempty"`
 	Phone            string           `json:"phone,omitempty"`
 	BusinessCategory BusinessCategory `json:"businessCategory,omitempty"`
+	CategoryCode     CategoryCode     `json:"categoryCode,omitempty"`
 }
 
 // Full onboarding data to be submitted.
@@ -81,7 +81,7 @@ func (os *OnboardingService) GetOnboardingStatus(ctx context.Context) (res *Resp
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &o); err != nil {
+	if err = res.decode(&o); err != nil {
 		return
 	}
 
@@ -90,6 +90,7 @@ func (os *OnboardingService) GetOnboardingStatus(ctx context.Context) (res *Resp
 
 // SubmitOnboardingData sends data that will be prefilled in the merchant’s onboarding.
 // Please note that the data you submit will only be processed when the onboarding status is needs-data.
+// To safely retry a submission, supply the same idempotency key on every attempt using WithIdempotencyKey.
 //
 // This endpoint has been deprecated. It will be supported for the foreseeable future, but new implementations should
 // use the Create client link endpoint to create new clients and submit their organization’s details in one go.
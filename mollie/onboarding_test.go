@@ -2,12 +2,14 @@ package mollie
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
 
 	"github.com/VictorAvelar/mollie-api-go/v4/testdata"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestOnboardingService_GetOnboardingStatus(t *testing.T) {
@@ -46,7 +48,7 @@ func TestOnboardingService_GetOnboardingStatus(t *testing.T) {
 		{
 			"get onboarding status, an error occurs when parsing json",
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding Onboarding: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -75,6 +77,7 @@ func TestOnboardingService_GetOnboardingStatus(t *testing.T) {
 				assert.Nil(t, err)
 				assert.IsType(t, &Onboarding{}, m)
 				assert.IsType(t, &http.Response{}, res.Response)
+				assert.Equal(t, "onboarding", m.Resource)
 			}
 		})
 	}
@@ -108,6 +111,25 @@ func TestOnboardingService_SubmitOnboardingData(t *testing.T) {
 				_, _ = w.Write([]byte(testdata.GetOnboardingStatusResponse))
 			},
 		},
+		{
+			"submit onboarding data sends the profile category code with an idempotency key.",
+			&OnboardingData{
+				Profile: OnboardingDataProfile{Name: "Mollie", CategoryCode: 5399},
+			},
+			false,
+			nil,
+			noPre,
+			func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "POST")
+				assert.NotEmpty(t, r.Header.Get(IdempotencyKeyHeader))
+
+				var d OnboardingData
+				require.Nil(t, json.NewDecoder(r.Body).Decode(&d))
+				assert.Equal(t, CategoryCode(5399), d.Profile.CategoryCode)
+
+				w.WriteHeader(http.StatusNoContent)
+			},
+		},
 		{
 			"get onboarding status, an error is returned from the server",
 			&OnboardingData{},
@@ -2,8 +2,9 @@ package mollie
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"math/big"
+	"net/http"
 	"time"
 )
 
@@ -20,18 +21,73 @@ type CreateOrder struct {
 	WebhookURL                               string          `json:"webhookUrl,omitempty"`
 	CancelURL                                string          `json:"cancelUrl,omitempty"`
 	Amount                                   *Amount         `json:"amount,omitempty"`
-	BillingAddress                           *OrderAddress   `json:"billingAddress,omitempty"`
-	ShippingAddress                          *OrderAddress   `json:"shippingAddress,omitempty"`
+	BillingAddress                           *Address        `json:"billingAddress,omitempty"`
+	ShippingAddress                          *Address        `json:"shippingAddress,omitempty"`
 	ConsumerDateOfBirth                      *ShortDate      `json:"consumerDateOfBirth,omitempty"`
 	Payment                                  *OrderPayment   `json:"payment,omitempty"`
 	ExpiresAt                                *ShortDate      `json:"expiresAt,omitempty"`
 	Lines                                    []OrderLine     `json:"lines,omitempty"`
 	Method                                   []PaymentMethod `json:"method,omitempty"`
 	Locale                                   Locale          `json:"locale,omitempty"`
-	Metadata                                 any             `json:"metadata,omitempty"`
+	Metadata                                 Metadata        `json:"metadata,omitempty"`
 	OrderAccessTokenFields
 }
 
+// Validate checks the locale is well formed, the amount is formatted with
+// the decimals of the currency and the order lines add up to it.
+//
+// Orders without an amount are left for Mollie to reject, every line of an
+// order with an amount must have a total amount in the same currency.
+func (co *CreateOrder) Validate() error {
+	if err := validateLocale(co.Locale); err != nil {
+		return err
+	}
+
+	if err := validateAddress("billingAddress", co.BillingAddress); err != nil {
+		return err
+	}
+
+	if err := validateAddress("shippingAddress", co.ShippingAddress); err != nil {
+		return err
+	}
+
+	for i := range co.Lines {
+		if err := validateOrderLine(fmt.Sprintf("lines[%d]", i), &co.Lines[i]); err != nil {
+			return err
+		}
+	}
+
+	if co.Amount == nil {
+		return nil
+	}
+
+	if err := validateAmount("amount", co.Amount); err != nil {
+		return err
+	}
+
+	sum := NewAmount(co.Amount.Currency, nil)
+
+	for i, l := range co.Lines {
+		if l.TotalAmount == nil {
+			return &ValidationError{Field: fmt.Sprintf("lines[%d].totalAmount", i), Reason: "is required"}
+		}
+
+		var err error
+		if sum, err = sum.Add(l.TotalAmount); err != nil {
+			return &ValidationError{Field: fmt.Sprintf("lines[%d].totalAmount", i), Reason: err.Error()}
+		}
+	}
+
+	if ok, err := sum.Equal(co.Amount); err != nil || !ok {
+		return &ValidationError{
+			Field:  "amount",
+			Reason: fmt.Sprintf("must equal the sum of the line totals %s %s", sum.Value, sum.Currency),
+		}
+	}
+
+	return nil
+}
+
 // OrderAccessTokenFields contains the fields available to include in an order when using an access token.
 type OrderAccessTokenFields struct {
 	ProfileID string `json:"profileId,omitempty"`
@@ -53,9 +109,9 @@ type Order struct {
 	Amount                                   *Amount       `json:"amount,omitempty"`
 	AmountCaptured                           *Amount       `json:"amountCaptured,omitempty"`
 	AmountRefunded                           *Amount       `json:"amountRefunded,omitempty"`
-	BillingAddress                           *OrderAddress `json:"billingAddress,omitempty"`
+	BillingAddress                           *Address      `json:"billingAddress,omitempty"`
 	ConsumerDateOfBirth                      *ShortDate    `json:"consumerDateOfBirth,omitempty"`
-	ShippingAddress                          *OrderAddress `json:"shippingAddress,omitempty"`
+	ShippingAddress                          *Address      `json:"shippingAddress,omitempty"`
 	CreatedAt                                *time.Time    `json:"createdAt,omitempty"`
 	ExpiresAt                                *time.Time    `json:"expiresAt,omitempty"`
 	ExpiredAt                                *time.Time    `json:"expiredAt,omitempty"`
@@ -68,7 +124,7 @@ type Order struct {
 	Locale                                   Locale        `json:"locale,omitempty"`
 	Status                                   OrderStatus   `json:"status,omitempty"`
 	Links                                    OrderLinks    `json:"_links,omitempty"`
-	Metadata                                 any           `json:"metadata,omitempty"`
+	Metadata                                 Metadata      `json:"metadata,omitempty"`
 	Embedded                                 struct {
 		Payments []*Payment `json:"payments,omitempty"`
 		Refunds  []*Refund  `json:"refunds,omitempty"`
@@ -76,23 +132,34 @@ type Order struct {
 }
 
 // UpdateOrder contains the parameters to update an order.
+//
+// Empty fields are left untouched, the webhook URL is cleared using Null[string]().
 type UpdateOrder struct {
-	OrderNumber     string        `json:"orderNumber,omitempty"`
-	RedirectURL     string        `json:"redirectUrl,omitempty"`
-	CancelURL       string        `json:"cancelUrl,omitempty"`
-	WebhookURL      string        `json:"webhookUrl,omitempty"`
-	BillingAddress  *OrderAddress `json:"billingAddress,omitempty"`
-	ShippingAddress *OrderAddress `json:"shippingAddress,omitempty"`
+	OrderNumber     string            `json:"orderNumber,omitempty"`
+	RedirectURL     string            `json:"redirectUrl,omitempty"`
+	CancelURL       string            `json:"cancelUrl,omitempty"`
+	WebhookURL      *Nullable[string] `json:"webhookUrl,omitempty"`
+	BillingAddress  *Address          `json:"billingAddress,omitempty"`
+	ShippingAddress *Address          `json:"shippingAddress,omitempty"`
 	OrderAccessTokenFields
 }
 
+// Validate checks the billing and shipping addresses of the update.
+func (uo *UpdateOrder) Validate() error {
+	if err := validateAddress("billingAddress", uo.BillingAddress); err != nil {
+		return err
+	}
+
+	return validateAddress("shippingAddress", uo.ShippingAddress)
+}
+
 // OrdersList for containing the response of list orders.
 type OrdersList struct {
 	Count    int `json:"count,omitempty"`
 	Embedded struct {
 		Orders []*Order `json:"orders,omitempty"`
 	} `json:"_embedded,omitempty"`
-	Links PaginationLinks `json:"links,omitempty"`
+	Links PaginationLinks `json:"_links,omitempty"`
 }
 
 // OrderLinks describes an object with several URL objects
@@ -105,6 +172,16 @@ type OrderLinks struct {
 	Dashboard     *URL `json:"dashboard,omitempty"`
 }
 
+// CheckoutURL returns the url the customer is redirected to in order to pay,
+// false when the order has no checkout link, e.g. once it has been paid.
+func (o *Order) CheckoutURL() (string, bool) {
+	if o == nil || o.Links.Checkout.String() == "" {
+		return "", false
+	}
+
+	return o.Links.Checkout.Href, true
+}
+
 // OrderStatus describes the valid order status.
 type OrderStatus string
 
@@ -119,6 +196,16 @@ const (
 	Expired    OrderStatus = "expired"
 )
 
+// IsFinal reports whether the order reached a status that will not change anymore.
+func (o OrderStatus) IsFinal() bool {
+	switch o {
+	case Canceled, Completed, Expired:
+		return true
+	default:
+		return false
+	}
+}
+
 // OrderPayment describes payment specific parameters that can be passed during order creation.
 type OrderPayment struct {
 	ApplePayPaymentToken string     `json:"applePayPaymentToken,omitempty"`
@@ -146,20 +233,9 @@ type OrderPayment struct {
 }
 
 // OrderAddress identify both the address and the person the order is billed or shipped to.
-type OrderAddress struct {
-	OrganizationName string      `json:"organizationName,omitempty"`
-	Title            string      `json:"title,omitempty"`
-	GivenName        string      `json:"givenName,omitempty"`
-	FamilyName       string      `json:"familyName,omitempty"`
-	Email            string      `json:"email,omitempty"`
-	StreetAndNumber  string      `json:"streetAndNumber,omitempty"`
-	StreetAdditional string      `json:"streetAdditional,omitempty"`
-	PostalCode       string      `json:"postalCode,omitempty"`
-	City             string      `json:"city,omitempty"`
-	Region           string      `json:"region,omitempty"`
-	Country          string      `json:"country,omitempty"`
-	Phone            PhoneNumber `json:"phone,omitempty"`
-}
+//
+// Deprecated: use Address instead.
+type OrderAddress = Address
 
 // OrderLine contain the actual things the customer bought.
 type OrderLine struct {
@@ -190,6 +266,116 @@ type OrderLine struct {
 	Links              OrderLineLinks  `json:"_links,omitempty"`
 }
 
+// Validate checks the amounts of the line add up the way Mollie expects,
+// so mistakes are reported before sending the order:
+//   - the total amount equals the unit price times the quantity minus the
+//     discount amount.
+//   - the VAT amount equals the total amount × (vatRate / (100 + vatRate)),
+//     rounded to the decimals of the currency with halves away from zero.
+//
+// The invariants are only checked when the involved fields are provided,
+// the missing ones are left for Mollie to reject.
+func (l *OrderLine) Validate() error {
+	return validateOrderLine("", l)
+}
+
+// validateOrderLine validates l, reporting errors for the fields nested
+// under field.
+func validateOrderLine(field string, l *OrderLine) error {
+	name := func(f string) string {
+		if field == "" {
+			return f
+		}
+
+		return field + "." + f
+	}
+
+	if l.UnitPrice != nil && l.Quantity < 1 {
+		return &ValidationError{Field: name("quantity"), Reason: "must be at least 1"}
+	}
+
+	if l.TotalAmount == nil {
+		return nil
+	}
+
+	if l.UnitPrice != nil {
+		if err := validateLineTotal(name("totalAmount"), l); err != nil {
+			return err
+		}
+	}
+
+	if l.VatAmount != nil && l.VatRate != "" {
+		if err := validateLineVAT(name, l); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateLineTotal checks the total amount equals the unit price times the
+// quantity minus the discount.
+func validateLineTotal(field string, l *OrderLine) error {
+	unit, err := l.UnitPrice.Rat()
+	if err != nil {
+		return &ValidationError{Field: field, Reason: err.Error()}
+	}
+
+	want := NewAmount(l.UnitPrice.Currency, unit.Mul(unit, big.NewRat(int64(l.Quantity), 1)))
+
+	if l.DiscountAmount != nil {
+		if want, err = want.Subtract(l.DiscountAmount); err != nil {
+			return &ValidationError{Field: field, Reason: err.Error()}
+		}
+	}
+
+	ok, err := l.TotalAmount.Equal(want)
+	if err != nil {
+		return &ValidationError{Field: field, Reason: err.Error()}
+	}
+
+	if !ok {
+		return &ValidationError{
+			Field:  field,
+			Reason: fmt.Sprintf("must equal the unit price times the quantity minus the discount %s", want),
+		}
+	}
+
+	return nil
+}
+
+// validateLineVAT checks the VAT amount equals the rounded VAT included in
+// the total amount.
+func validateLineVAT(name func(string) string, l *OrderLine) error {
+	rate, ok := new(big.Rat).SetString(l.VatRate)
+	if !ok || rate.Sign() < 0 {
+		return &ValidationError{Field: name("vatRate"), Reason: fmt.Sprintf("%q is not a valid percentage", l.VatRate)}
+	}
+
+	total, err := l.TotalAmount.Rat()
+	if err != nil {
+		return &ValidationError{Field: name("totalAmount"), Reason: err.Error()}
+	}
+
+	divisor := new(big.Rat).Add(rate, big.NewRat(100, 1))
+	vat := total.Mul(total, rate.Quo(rate, divisor))
+	want := NewAmount(l.TotalAmount.Currency, vat)
+
+	ok, err = l.VatAmount.Equal(want)
+	if err != nil {
+		return &ValidationError{Field: name("vatAmount"), Reason: err.Error()}
+	}
+
+	if !ok {
+		return &ValidationError{
+			Field:  name("vatAmount"),
+			Reason: fmt.Sprintf("must equal the VAT included in the total amount %s", want),
+		}
+	}
+
+	return nil
+}
+
 // OrderLineLinks describes object with several URL objects relevant to the order line.
 type OrderLineLinks struct {
 	ProductURL *URL `json:"productUrl,omitempty"`
@@ -198,17 +384,33 @@ type OrderLineLinks struct {
 
 // UpdateOrderLine contains the parameters to update an order line.
 type UpdateOrderLine struct {
-	Quantity       int     `json:"quantity,omitempty"`
-	Name           string  `json:"name,omitempty"`
-	ImageURL       string  `json:"imageUrl,omitempty"`
-	ProductURL     string  `json:"productUrl,omitempty"`
-	SKU            string  `json:"sku,omitempty"`
-	VATRate        string  `json:"vatRate,omitempty"`
-	UnitPrice      *Amount `json:"unitPrice,omitempty"`
-	DiscountAmount *Amount `json:"discountAmount,omitempty"`
-	TotalAmount    *Amount `json:"totalAmount,omitempty"`
-	VATAmount      *Amount `json:"vatAmount,omitempty"`
-	Metadata       any     `json:"metadata,omitempty"`
+	Quantity       int      `json:"quantity,omitempty"`
+	Name           string   `json:"name,omitempty"`
+	ImageURL       string   `json:"imageUrl,omitempty"`
+	ProductURL     string   `json:"productUrl,omitempty"`
+	SKU            string   `json:"sku,omitempty"`
+	VATRate        string   `json:"vatRate,omitempty"`
+	UnitPrice      *Amount  `json:"unitPrice,omitempty"`
+	DiscountAmount *Amount  `json:"discountAmount,omitempty"`
+	TotalAmount    *Amount  `json:"totalAmount,omitempty"`
+	VATAmount      *Amount  `json:"vatAmount,omitempty"`
+	Metadata       Metadata `json:"metadata,omitempty"`
+	OrderAccessTokenFields
+}
+
+// CancelOrderLine describes the quantity of an order line to cancel.
+//
+// When the quantity is omitted all the cancelable units of the line are canceled,
+// the amount is only required for lines that were partially shipped or refunded.
+type CancelOrderLine struct {
+	ID       string  `json:"id,omitempty"`
+	Quantity int     `json:"quantity,omitempty"`
+	Amount   *Amount `json:"amount,omitempty"`
+}
+
+// cancelOrderLines is the body of the cancel order lines request.
+type cancelOrderLines struct {
+	Lines []*CancelOrderLine `json:"lines,omitempty"`
 	OrderAccessTokenFields
 }
 
@@ -248,7 +450,7 @@ type OrderLineOperationData struct {
 	DiscountAmount *Amount                           `json:"discountAmount,omitempty"`
 	VATAmount      *Amount                           `json:"vatAmount,omitempty"`
 	TotalAmount    *Amount                           `json:"totalAmount,omitempty"`
-	Metadata       any                               `json:"metadata,omitempty"`
+	Metadata       Metadata                          `json:"metadata,omitempty"`
 	OrderAccessTokenFields
 }
 
@@ -267,9 +469,9 @@ type OrderLineOperations struct {
 type OrderRefundsList struct {
 	Count    int `json:"count,omitempty"`
 	Embedded struct {
-		Refunds []*Refund `json:"refund,omitempty"`
+		Refunds []*Refund `json:"refunds,omitempty"`
 	} `json:"_embedded,omitempty"`
-	Links PaginationLinks `json:"links,omitempty"`
+	Links PaginationLinks `json:"_links,omitempty"`
 }
 
 // ProductKind describes the type of product bought, for example, a physical or a digital product.
@@ -302,10 +504,13 @@ const (
 // OrderOptions describes order endpoint valid query string parameters.
 type OrderOptions struct {
 	ProfileID string       `url:"profileId,omitempty"`
-	Embed     []EmbedValue `url:"embed,omitempty"`
+	Embed     []EmbedValue `url:"embed,omitempty,comma"`
 }
 
 // ListOrdersOptions describes order endpoint valid query string parameters.
+//
+// Sort is kept as a string for compatibility, use the SortDirection
+// values, e.g. string(SortAsc).
 type ListOrdersOptions struct {
 	Limit     int    `url:"limit,omitempty"`
 	From      string `url:"from,omitempty"`
@@ -336,7 +541,7 @@ func (ors *OrdersService) Get(ctx context.Context, orID string, opts *OrderOptio
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &order); err != nil {
+	if err = res.decode(&order); err != nil {
 		return
 	}
 
@@ -345,8 +550,11 @@ func (ors *OrdersService) Get(ctx context.Context, orID string, opts *OrderOptio
 
 // Create an order will automatically create the required payment to allow your customer to pay for the order.
 //
+// Orders created using an organization or OAuth access token must tell the
+// profile they belong to using ProfileID, see Client.WithProfileScopeValidation.
+//
 // See https://docs.mollie.com/reference/v2/orders-api/create-order
-func (ors *OrdersService) Create(ctx context.Context, ord CreateOrder, opts *OrderOptions) (
+func (ors *OrdersService) Create(ctx context.Context, ord CreateOrder, opts *OrderOptions, reqOpts ...RequestOption) (
 	res *Response,
 	order *Order,
 	err error,
@@ -355,12 +563,20 @@ func (ors *OrdersService) Create(ctx context.Context, ord CreateOrder, opts *Ord
 		ord.Testmode = true
 	}
 
-	res, err = ors.client.post(ctx, "v2/orders", ord, opts)
+	if err = ord.Validate(); err != nil {
+		return
+	}
+
+	if err = ors.client.validateCreateProfile(ord.ProfileID); err != nil {
+		return
+	}
+
+	res, err = ors.client.post(ctx, "v2/orders", ord, opts, reqOpts...)
 	if err != nil {
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &order); err != nil {
+	if err = res.decode(&order); err != nil {
 		return
 	}
 
@@ -370,7 +586,7 @@ func (ors *OrdersService) Create(ctx context.Context, ord CreateOrder, opts *Ord
 // Update is used to update the billing and/or shipping address of an order.
 //
 // See https://docs.mollie.com/reference/v2/orders-api/update-order
-func (ors *OrdersService) Update(ctx context.Context, orderID string, ord UpdateOrder) (
+func (ors *OrdersService) Update(ctx context.Context, orderID string, ord UpdateOrder, reqOpts ...RequestOption) (
 	res *Response,
 	order *Order,
 	err error,
@@ -379,12 +595,16 @@ func (ors *OrdersService) Update(ctx context.Context, orderID string, ord Update
 		ord.Testmode = true
 	}
 
-	res, err = ors.client.patch(ctx, fmt.Sprintf("v2/orders/%s", orderID), ord)
+	if err = ord.Validate(); err != nil {
+		return
+	}
+
+	res, err = ors.client.patch(ctx, fmt.Sprintf("v2/orders/%s", orderID), ord, reqOpts...)
 	if err != nil {
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &order); err != nil {
+	if err = res.decode(&order); err != nil {
 		return
 	}
 
@@ -394,13 +614,17 @@ func (ors *OrdersService) Update(ctx context.Context, orderID string, ord Update
 // Cancel try to cancel the order that fulfill certain requirements.
 //
 // See https://docs.mollie.com/reference/v2/orders-api/cancel-order
-func (ors *OrdersService) Cancel(ctx context.Context, orderID string) (res *Response, order *Order, err error) {
-	res, err = ors.client.delete(ctx, fmt.Sprintf("v2/orders/%s", orderID))
+func (ors *OrdersService) Cancel(
+	ctx context.Context,
+	orderID string,
+	reqOpts ...RequestOption,
+) (res *Response, order *Order, err error) {
+	res, err = ors.client.delete(ctx, fmt.Sprintf("v2/orders/%s", orderID), reqOpts...)
 	if err != nil {
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &order); err != nil {
+	if err = res.decodeOptional(&order); err != nil {
 		return
 	}
 
@@ -420,7 +644,7 @@ func (ors *OrdersService) List(ctx context.Context, opts *ListOrdersOptions) (
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &ordList); err != nil {
+	if err = res.decode(&ordList); err != nil {
 		return
 	}
 
@@ -434,7 +658,9 @@ func (ors *OrdersService) UpdateOrderLine(
 	ctx context.Context,
 	orderID string,
 	orderLineID string,
-	orderLine UpdateOrderLine) (
+	orderLine UpdateOrderLine,
+	reqOpts ...RequestOption,
+) (
 	res *Response,
 	order *Order,
 	err error,
@@ -445,12 +671,12 @@ func (ors *OrdersService) UpdateOrderLine(
 		orderLine.Testmode = true
 	}
 
-	res, err = ors.client.patch(ctx, u, orderLine)
+	res, err = ors.client.patch(ctx, u, orderLine, reqOpts...)
 	if err != nil {
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &order); err != nil {
+	if err = res.decode(&order); err != nil {
 		return
 	}
 
@@ -461,38 +687,108 @@ func (ors *OrdersService) UpdateOrderLine(
 // that were previously authorized using a pay after delivery payment method.
 // Use the Cancel Order API if you want to cancel the entire order or the remainder of the order.
 //
+// Only the id and quantity of each line are sent, use CancelLines to also
+// provide an amount or to retrieve the updated order.
+//
 // See https://docs.mollie.com/reference/v2/orders-api/cancel-order-lines
-func (ors *OrdersService) CancelOrderLines(ctx context.Context, orderID string, orderLines []OrderLine) (
+func (ors *OrdersService) CancelOrderLines(
+	ctx context.Context,
+	orderID string,
+	orderLines []OrderLine,
+	reqOpts ...RequestOption,
+) (
 	res *Response,
 	err error,
 ) {
-	u := fmt.Sprintf("v2/orders/%s/lines", orderID)
+	lines := make([]*CancelOrderLine, 0, len(orderLines))
+	for _, l := range orderLines {
+		lines = append(lines, &CancelOrderLine{ID: l.ID, Quantity: l.Quantity})
+	}
+
+	return ors.cancelLines(ctx, orderID, lines, reqOpts...)
+}
 
-	res, err = ors.client.delete(ctx, u)
+// CancelLines cancels the given quantities of one or more order lines and
+// returns the updated order so the new order and line statuses are visible.
+//
+// Mollie answers the cancellation with 204 No Content, in that case the
+// order is retrieved afterwards and the returned response is the one
+// of the retrieval.
+//
+// See https://docs.mollie.com/reference/v2/orders-api/cancel-order-lines
+func (ors *OrdersService) CancelLines(
+	ctx context.Context,
+	orderID string,
+	lines []*CancelOrderLine,
+	reqOpts ...RequestOption,
+) (
+	res *Response,
+	order *Order,
+	err error,
+) {
+	res, err = ors.cancelLines(ctx, orderID, lines, reqOpts...)
 	if err != nil {
 		return
 	}
 
+	if res.empty() {
+		return ors.Get(ctx, orderID, nil)
+	}
+
+	if err = res.decode(&order); err != nil {
+		return
+	}
+
 	return
 }
 
+func (ors *OrdersService) cancelLines(
+	ctx context.Context,
+	orderID string,
+	lines []*CancelOrderLine,
+	reqOpts ...RequestOption,
+) (
+	res *Response,
+	err error,
+) {
+	body := cancelOrderLines{Lines: lines}
+
+	if ors.client.HasAccessToken() && ors.client.config.testing {
+		body.Testmode = true
+	}
+
+	u := fmt.Sprintf("v2/orders/%s/lines", orderID)
+
+	req, err := ors.client.NewAPIRequest(ctx, http.MethodDelete, u, body, reqOpts...)
+	if err != nil {
+		return
+	}
+
+	return ors.client.Do(req)
+}
+
 // CreateOrderPayment can only be created while the status of the order is created,
 // and when the status of the existing payment is either expired, canceled or failed.
 //
 // See https://docs.mollie.com/reference/v2/orders-api/create-order-payment
-func (ors *OrdersService) CreateOrderPayment(ctx context.Context, orderID string, ordPay *OrderPayment) (
+func (ors *OrdersService) CreateOrderPayment(
+	ctx context.Context,
+	orderID string,
+	ordPay *OrderPayment,
+	reqOpts ...RequestOption,
+) (
 	res *Response,
 	payment *Payment,
 	err error,
 ) {
 	u := fmt.Sprintf("v2/orders/%s/payments", orderID)
 
-	res, err = ors.client.post(ctx, u, ordPay, nil)
+	res, err = ors.client.post(ctx, u, ordPay, nil, reqOpts...)
 	if err != nil {
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &payment); err != nil {
+	if err = res.decode(&payment); err != nil {
 		return
 	}
 
@@ -502,19 +798,24 @@ func (ors *OrdersService) CreateOrderPayment(ctx context.Context, orderID string
 // CreateOrderRefund using the Orders API, refunds should be made against the order.
 //
 // See https://docs.mollie.com/reference/v2/orders-api/create-order-refund
-func (ors *OrdersService) CreateOrderRefund(ctx context.Context, orderID string, order *Order) (
+func (ors *OrdersService) CreateOrderRefund(
+	ctx context.Context,
+	orderID string,
+	order *Order,
+	reqOpts ...RequestOption,
+) (
 	res *Response,
 	refund *Refund,
 	err error,
 ) {
 	u := fmt.Sprintf("v2/orders/%s/refunds", orderID)
 
-	res, err = ors.client.post(ctx, u, order, nil)
+	res, err = ors.client.post(ctx, u, order, nil, reqOpts...)
 	if err != nil {
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &refund); err != nil {
+	if err = res.decode(&refund); err != nil {
 		return
 	}
 
@@ -536,7 +837,7 @@ func (ors *OrdersService) ListOrderRefunds(ctx context.Context, orderID string,
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &orderListRefund); err != nil {
+	if err = res.decode(&orderListRefund); err != nil {
 		return
 	}
 
@@ -546,19 +847,24 @@ func (ors *OrdersService) ListOrderRefunds(ctx context.Context, orderID string,
 // ManageOrderLines allows to update, cancel, or add one or more order lines.
 //
 // See: https://docs.mollie.com/reference/v2/orders-api/manage-order-lines
-func (ors *OrdersService) ManageOrderLines(ctx context.Context, orderID string, operations *OrderLineOperations) (
+func (ors *OrdersService) ManageOrderLines(
+	ctx context.Context,
+	orderID string,
+	operations *OrderLineOperations,
+	reqOpts ...RequestOption,
+) (
 	res *Response,
 	order *Order,
 	err error,
 ) {
 	u := fmt.Sprintf("v2/orders/%s/lines", orderID)
 
-	res, err = ors.client.patch(ctx, u, operations)
+	res, err = ors.client.patch(ctx, u, operations, reqOpts...)
 	if err != nil {
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &order); err != nil {
+	if err = res.decode(&order); err != nil {
 		return
 	}
 
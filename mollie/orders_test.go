@@ -2,12 +2,15 @@ package mollie
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
 
 	"github.com/VictorAvelar/mollie-api-go/v4/testdata"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestOrdersService_Get(t *testing.T) {
@@ -70,7 +73,7 @@ func TestOrdersService_Get(t *testing.T) {
 				nil,
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding Order: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -146,7 +149,7 @@ func TestOrdersService_List(t *testing.T) {
 				if _, ok := r.Header[AuthHeader]; !ok {
 					w.WriteHeader(http.StatusUnauthorized)
 				}
-				_, _ = w.Write([]byte(testdata.GetOrderResponse))
+				_, _ = w.Write([]byte(testdata.OrderListResponse))
 			},
 		},
 		{
@@ -169,7 +172,7 @@ func TestOrdersService_List(t *testing.T) {
 				nil,
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding OrdersList: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -203,6 +206,7 @@ func TestOrdersService_List(t *testing.T) {
 				assert.Nil(t, err)
 				assert.IsType(t, &OrdersList{}, m)
 				assert.IsType(t, &http.Response{}, res.Response)
+				assert.Equal(t, "https://api.mollie.com/v2/orders?from=ord_stTC2WHAuS", m.Links.Next.Href)
 			}
 		})
 	}
@@ -252,7 +256,8 @@ func TestOrdersService_Create(t *testing.T) {
 			args{
 				context.Background(),
 				CreateOrder{
-					Method: []PaymentMethod{PayPal},
+					Method:                 []PaymentMethod{PayPal},
+					OrderAccessTokenFields: OrderAccessTokenFields{ProfileID: "pfl_3RkSN1zuPE"},
 				},
 				&OrderOptions{},
 			},
@@ -295,10 +300,28 @@ func TestOrdersService_Create(t *testing.T) {
 				nil,
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding Order: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
+		{
+			"create orders, line totals do not add up to the order amount",
+			args{
+				context.Background(),
+				CreateOrder{
+					Amount: &Amount{Currency: "EUR", Value: "100.00"},
+					Lines: []OrderLine{
+						{TotalAmount: &Amount{Currency: "EUR", Value: "60.00"}},
+						{TotalAmount: &Amount{Currency: "EUR", Value: "30.00"}},
+					},
+				},
+				nil,
+			},
+			true,
+			fmt.Errorf("validation_error: amount must equal the sum of the line totals 90.00 EUR"),
+			noPre,
+			errorHandler,
+		},
 		{
 			"create orders, invalid url when building request",
 			args{
@@ -417,7 +440,7 @@ func TestOrdersService_Update(t *testing.T) {
 				nil,
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding Order: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -544,7 +567,7 @@ func TestOrdersService_Cancel(t *testing.T) {
 				nil,
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding Order: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -673,7 +696,7 @@ func TestOrdersService_UpdateOrderLine(t *testing.T) {
 				UpdateOrderLine{},
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding Order: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -834,6 +857,85 @@ func TestOrdersService_CancelOrderLine(t *testing.T) {
 	}
 }
 
+func TestOrdersService_CancelLines(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+
+	lines := []*CancelOrderLine{{ID: "odl_dgtxyl", Quantity: 1}, {ID: "odl_jp31jz"}}
+
+	cases := []struct {
+		name    string
+		wantErr bool
+		err     error
+		pre     func()
+		handler http.HandlerFunc
+	}{
+		{
+			"cancel order lines retrieves the order after a no content response",
+			false,
+			nil,
+			noPre,
+			func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "DELETE")
+
+				var body cancelOrderLines
+				require.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, lines, body.Lines)
+
+				w.WriteHeader(http.StatusNoContent)
+			},
+		},
+		{
+			"cancel order lines uses the order returned by the cancellation",
+			false,
+			nil,
+			noPre,
+			func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "DELETE")
+				_, _ = w.Write([]byte(testdata.GetOrderResponse))
+			},
+		},
+		{
+			"cancel order lines, an error is returned from the server",
+			true,
+			fmt.Errorf("500 Internal Server Error: An internal server error occurred while processing your request."),
+			noPre,
+			errorHandler,
+		},
+		{
+			"cancel order lines, invalid url when building request",
+			true,
+			errBadBaseURL,
+			crashSrv,
+			errorHandler,
+		},
+	}
+
+	for _, c := range cases {
+		setup()
+		defer teardown()
+
+		t.Run(c.name, func(t *testing.T) {
+			c.pre()
+			tMux.HandleFunc("/v2/orders/ord_kEn1PlbGa/lines", c.handler)
+			tMux.HandleFunc("/v2/orders/ord_kEn1PlbGa", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "GET")
+				_, _ = w.Write([]byte(testdata.GetOrderResponse))
+			})
+
+			res, o, err := tClient.Orders.CancelLines(context.Background(), "ord_kEn1PlbGa", lines)
+			if c.wantErr {
+				assert.NotNil(t, err)
+				assert.EqualError(t, err, c.err.Error())
+			} else {
+				assert.Nil(t, err)
+				assert.IsType(t, &http.Response{}, res.Response)
+				assert.Equal(t, "ord_kEn1PlbGa", o.ID)
+			}
+		})
+	}
+}
+
 func TestOrdersService_CreateOrderPayment(t *testing.T) {
 	setEnv()
 	defer unsetEnv()
@@ -918,7 +1020,7 @@ func TestOrdersService_CreateOrderPayment(t *testing.T) {
 				nil,
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding Payment: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -1031,7 +1133,7 @@ func TestOrdersService_CreateOrderRefund(t *testing.T) {
 				&Order{ID: "ord_8wmqcHMN4U"},
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding Refund: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -1104,7 +1206,7 @@ func TestOrdersService_ListOrderRefund(t *testing.T) {
 				if _, ok := r.Header[AuthHeader]; !ok {
 					w.WriteHeader(http.StatusUnauthorized)
 				}
-				_, _ = w.Write([]byte(testdata.CreateOrderRefundResponse))
+				_, _ = w.Write([]byte(testdata.ListOrderRefundResponse))
 			},
 		},
 		{
@@ -1126,7 +1228,7 @@ func TestOrdersService_ListOrderRefund(t *testing.T) {
 				if _, ok := r.Header[AuthHeader]; !ok {
 					w.WriteHeader(http.StatusUnauthorized)
 				}
-				_, _ = w.Write([]byte(testdata.CreateOrderRefundResponse))
+				_, _ = w.Write([]byte(testdata.ListOrderRefundResponse))
 			},
 		},
 		{
@@ -1149,7 +1251,7 @@ func TestOrdersService_ListOrderRefund(t *testing.T) {
 				nil,
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding OrderRefundsList: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -1183,6 +1285,8 @@ func TestOrdersService_ListOrderRefund(t *testing.T) {
 				assert.Nil(t, err)
 				assert.IsType(t, &OrderRefundsList{}, m)
 				assert.IsType(t, &http.Response{}, res.Response)
+				assert.NotEmpty(t, m.Embedded.Refunds)
+				assert.NotNil(t, m.Links.Next)
 			}
 		})
 	}
@@ -1307,7 +1411,7 @@ func TestOrdersService_ManageOrderLines(t *testing.T) {
 				},
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding Order: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -1355,3 +1459,280 @@ func TestOrdersService_ManageOrderLines(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateOrder_Validate(t *testing.T) {
+	cases := []struct {
+		name  string
+		order CreateOrder
+		field string
+	}{
+		{
+			"orders without amount are not validated",
+			CreateOrder{Method: []PaymentMethod{PayPal}},
+			"",
+		},
+		{
+			"line totals matching the amount are valid",
+			CreateOrder{
+				Amount: &Amount{Currency: "EUR", Value: "100.00"},
+				Lines: []OrderLine{
+					{TotalAmount: &Amount{Currency: "EUR", Value: "60.50"}},
+					{TotalAmount: &Amount{Currency: "EUR", Value: "39.5"}},
+				},
+			},
+			"",
+		},
+		{
+			"lines without total amount are rejected",
+			CreateOrder{
+				Amount: &Amount{Currency: "EUR", Value: "100.00"},
+				Lines:  []OrderLine{{Name: "LEGO 4440 Forest Police Station"}},
+			},
+			"lines[0].totalAmount",
+		},
+		{
+			"lines in a different currency are rejected",
+			CreateOrder{
+				Amount: &Amount{Currency: "EUR", Value: "100.00"},
+				Lines:  []OrderLine{{TotalAmount: &Amount{Currency: "USD", Value: "100.00"}}},
+			},
+			"lines[0].totalAmount",
+		},
+		{
+			"orders without lines are rejected",
+			CreateOrder{Amount: &Amount{Currency: "EUR", Value: "100.00"}},
+			"amount",
+		},
+		{
+			"orders with a malformed locale are rejected",
+			CreateOrder{Locale: "english"},
+			"locale",
+		},
+		{
+			"lines with inconsistent vat are rejected with their index",
+			CreateOrder{
+				Amount: &Amount{Currency: "EUR", Value: "20.00"},
+				Lines: []OrderLine{
+					{TotalAmount: &Amount{Currency: "EUR", Value: "10.00"}},
+					{
+						TotalAmount: &Amount{Currency: "EUR", Value: "10.00"},
+						VatRate:     "21.00",
+						VatAmount:   &Amount{Currency: "EUR", Value: "2.10"},
+					},
+				},
+			},
+			"lines[1].vatAmount",
+		},
+		{
+			"orders with an invalid billing country are rejected",
+			CreateOrder{BillingAddress: &Address{Country: "Netherlands"}},
+			"billingAddress.country",
+		},
+		{
+			"orders with an incomplete shipping address are rejected",
+			CreateOrder{ShippingAddress: &Address{StreetAndNumber: "Keizersgracht 126", Country: "NL"}},
+			"shippingAddress.city",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := c.order.Validate()
+			if c.field == "" {
+				assert.Nil(t, err)
+				return
+			}
+
+			var ve *ValidationError
+			require.True(t, errors.As(err, &ve))
+			assert.Equal(t, c.field, ve.Field)
+		})
+	}
+}
+
+func TestOrdersService_Create_ProfileID(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	tMux.HandleFunc("/v2/orders", func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("orders without profile id must not be sent with access tokens")
+	})
+
+	tClient.WithAuthenticationValue("access_token_test")
+
+	_, _, err := tClient.Orders.Create(context.Background(), CreateOrder{Method: []PaymentMethod{PayPal}}, nil)
+
+	var ve *ValidationError
+	require.True(t, errors.As(err, &ve))
+	assert.Equal(t, "profileId", ve.Field)
+}
+
+func TestOrdersService_Update_InvalidAddress(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	tMux.HandleFunc("/v2/orders/ord_kEn1PlbGa", func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("invalid updates must not be sent")
+	})
+
+	_, _, err := tClient.Orders.Update(context.Background(), "ord_kEn1PlbGa", UpdateOrder{
+		ShippingAddress: &Address{City: "Amsterdam", Country: "nl"},
+	})
+
+	var ve *ValidationError
+	require.True(t, errors.As(err, &ve))
+	assert.Equal(t, "shippingAddress.country", ve.Field)
+}
+
+func TestOrderLine_Validate(t *testing.T) {
+	eur := func(v string) *Amount { return &Amount{Currency: "EUR", Value: v} }
+
+	cases := []struct {
+		name  string
+		line  OrderLine
+		field string
+	}{
+		{
+			"lines with consistent amounts are valid",
+			OrderLine{
+				Quantity:    2,
+				UnitPrice:   eur("60.00"),
+				TotalAmount: eur("120.00"),
+				VatRate:     "21.00",
+				VatAmount:   eur("20.83"),
+			},
+			"",
+		},
+		{
+			"discounts are subtracted from the total",
+			OrderLine{
+				Quantity:       2,
+				UnitPrice:      eur("399.00"),
+				DiscountAmount: eur("100.00"),
+				TotalAmount:    eur("698.00"),
+				VatRate:        "21.00",
+				VatAmount:      eur("121.14"),
+			},
+			"",
+		},
+		{
+			"vat amounts are rounded to the nearest cent",
+			OrderLine{Quantity: 1, UnitPrice: eur("10.00"), TotalAmount: eur("10.00"), VatRate: "21", VatAmount: eur("1.74")},
+			"",
+		},
+		{
+			"vat amounts are not truncated",
+			OrderLine{Quantity: 1, UnitPrice: eur("10.00"), TotalAmount: eur("10.00"), VatRate: "21", VatAmount: eur("1.73")},
+			"vatAmount",
+		},
+		{
+			"vat halves are rounded up",
+			OrderLine{Quantity: 1, UnitPrice: eur("0.01"), TotalAmount: eur("0.01"), VatRate: "100", VatAmount: eur("0.01")},
+			"",
+		},
+		{
+			"vat halves are not rounded down",
+			OrderLine{Quantity: 1, UnitPrice: eur("0.01"), TotalAmount: eur("0.01"), VatRate: "100", VatAmount: eur("0.00")},
+			"vatAmount",
+		},
+		{
+			"negative vat halves are rounded away from zero",
+			OrderLine{
+				Quantity:    1,
+				UnitPrice:   eur("-0.01"),
+				TotalAmount: eur("-0.01"),
+				VatRate:     "100",
+				VatAmount:   eur("-0.01"),
+			},
+			"",
+		},
+		{
+			"zero vat rates require a zero vat amount",
+			OrderLine{Quantity: 3, UnitPrice: eur("5.00"), TotalAmount: eur("15.00"), VatRate: "0.00", VatAmount: eur("0.00")},
+			"",
+		},
+		{
+			"vat amounts use the decimals of the currency",
+			OrderLine{
+				Quantity:    1,
+				UnitPrice:   &Amount{Currency: "JPY", Value: "1000"},
+				TotalAmount: &Amount{Currency: "JPY", Value: "1000"},
+				VatRate:     "10",
+				VatAmount:   &Amount{Currency: "JPY", Value: "91"},
+			},
+			"",
+		},
+		{
+			"totals not matching the unit price are rejected",
+			OrderLine{Quantity: 2, UnitPrice: eur("60.00"), TotalAmount: eur("60.00")},
+			"totalAmount",
+		},
+		{
+			"lines in mixed currencies are rejected",
+			OrderLine{Quantity: 1, UnitPrice: eur("60.00"), TotalAmount: &Amount{Currency: "USD", Value: "60.00"}},
+			"totalAmount",
+		},
+		{
+			"lines without quantity are rejected",
+			OrderLine{UnitPrice: eur("60.00"), TotalAmount: eur("60.00")},
+			"quantity",
+		},
+		{
+			"malformed vat rates are rejected",
+			OrderLine{TotalAmount: eur("60.00"), VatRate: "21%", VatAmount: eur("10.41")},
+			"vatRate",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := c.line.Validate()
+			if c.field == "" {
+				assert.Nil(t, err)
+				return
+			}
+
+			var ve *ValidationError
+			require.True(t, errors.As(err, &ve))
+			assert.Equal(t, c.field, ve.Field)
+		})
+	}
+}
+
+func TestOrderStatus_IsFinal(t *testing.T) {
+	final := map[OrderStatus]bool{
+		Created:    false,
+		Paid:       false,
+		Authorized: false,
+		Shipping:   false,
+		Canceled:   true,
+		Completed:  true,
+		Expired:    true,
+	}
+
+	for status, want := range final {
+		assert.Equal(t, want, status.IsFinal(), status)
+	}
+}
+
+func TestOrder_CheckoutURL(t *testing.T) {
+	var o Order
+
+	require.Nil(t, json.Unmarshal([]byte(testdata.GetOrderResponse), &o))
+
+	href, ok := o.CheckoutURL()
+	assert.True(t, ok)
+	assert.Equal(t, "https://www.mollie.com/payscreen/order/checkout/pbjz8x", href)
+
+	href, ok = (&Order{Links: OrderLinks{Checkout: &URL{}}}).CheckoutURL()
+	assert.False(t, ok)
+	assert.Empty(t, href)
+
+	var no *Order
+	_, ok = no.CheckoutURL()
+	assert.False(t, ok)
+}
@@ -2,7 +2,6 @@ package mollie
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"time"
 )
@@ -48,9 +47,9 @@ const (
 
 // UserAgentToken are time limited valid access tokens.
 type UserAgentToken struct {
-	Token    string
-	StartsAt *time.Time
-	EndsAt   *time.Time
+	Token    string     `json:"token,omitempty"`
+	StartsAt *time.Time `json:"startsAt,omitempty"`
+	EndsAt   *time.Time `json:"endsAt,omitempty"`
 }
 
 // OrganizationPartnerLinks is an object with several URL objects
@@ -80,9 +79,18 @@ func (os *OrganizationsService) Get(ctx context.Context, id string) (res *Respon
 	return os.get(ctx, fmt.Sprintf("v2/organizations/%s", id))
 }
 
+// Current retrieves the organization the authentication token belongs to.
+//
+// See: https://docs.mollie.com/reference/v2/organizations-api/current-organization
+func (os *OrganizationsService) Current(ctx context.Context) (res *Response, o *Organization, err error) {
+	return os.get(ctx, "v2/organizations/me")
+}
+
 // GetCurrent retrieve the currently authenticated organization.
+//
+// Deprecated: use Current instead.
 func (os *OrganizationsService) GetCurrent(ctx context.Context) (res *Response, o *Organization, err error) {
-	return os.get(ctx, "v2/organizations/me")
+	return os.Current(ctx)
 }
 
 // GetPartnerStatus retrieves details about the partner status
@@ -99,7 +107,7 @@ func (os *OrganizationsService) GetPartnerStatus(ctx context.Context) (
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &ops); err != nil {
+	if err = res.decode(&ops); err != nil {
 		return
 	}
 
@@ -112,7 +120,7 @@ func (os *OrganizationsService) get(ctx context.Context, uri string) (res *Respo
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &o); err != nil {
+	if err = res.decode(&o); err != nil {
 		return
 	}
 
@@ -64,7 +64,7 @@ func TestOrganizationsService_Get(t *testing.T) {
 				"org_12345678",
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding Organization: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -152,7 +152,7 @@ func TestOrganizationsService_GetCurrent(t *testing.T) {
 				context.Background(),
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding Organization: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -189,6 +189,25 @@ func TestOrganizationsService_GetCurrent(t *testing.T) {
 	}
 }
 
+func TestOrganizationsService_Current(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	tMux.HandleFunc("/v2/organizations/me", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = w.Write([]byte(testdata.GetOrganizationResponse))
+	})
+
+	res, o, err := tClient.Organizations.Current(context.Background())
+	assert.Nil(t, err)
+	assert.IsType(t, &http.Response{}, res.Response)
+	assert.Equal(t, "org_12345678", o.ID)
+	assert.Equal(t, "Amsterdam", o.Address.City)
+	assert.Equal(t, "NL815839091B01", o.VatNumber)
+}
+
 func TestOrganizationsService_GetPartnerStatus(t *testing.T) {
 	setEnv()
 	defer unsetEnv()
@@ -239,7 +258,7 @@ func TestOrganizationsService_GetPartnerStatus(t *testing.T) {
 				context.Background(),
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding OrganizationPartnerStatus: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
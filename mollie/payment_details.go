@@ -1,5 +1,14 @@
 package mollie
 
+import (
+	"encoding/json"
+	"errors"
+)
+
+// ErrPaymentWithoutDetails is returned by DetailsAs when the payment
+// contains no method specific details.
+var ErrPaymentWithoutDetails = errors.New("payment without details")
+
 // FeeRegion contains the fee region for the payment.
 type FeeRegion string
 
@@ -96,6 +105,35 @@ type PaymentDetails struct {
 		Status    *URL `json:"status,omitempty"`
 		PayOnline *URL `json:"payOnline,omitempty"`
 	} `json:"_links,omitempty"`
+	raw json.RawMessage
+}
+
+// UnmarshalJSON decodes the known details and keeps the raw object, so
+// method specific fields not described by PaymentDetails can be decoded
+// later using DetailsAs.
+func (d *PaymentDetails) UnmarshalJSON(data []byte) error {
+	type details PaymentDetails
+
+	var typed details
+	if err := json.Unmarshal(data, &typed); err != nil {
+		return err
+	}
+
+	*d = PaymentDetails(typed)
+	d.raw = append(json.RawMessage(nil), data...)
+
+	return nil
+}
+
+// DetailsAs decodes the method specific details of the payment into v,
+// which allows using a custom struct for details whose shape depends on
+// the payment method, e.g. the QR code requested with IncludeQrCode.
+func (p *Payment) DetailsAs(v any) error {
+	if p == nil || len(p.Details.raw) == 0 || string(p.Details.raw) == "null" {
+		return ErrPaymentWithoutDetails
+	}
+
+	return json.Unmarshal(p.Details.raw, v)
 }
 
 // PaymentDetailsAddress identify both the address and the person the payment is shipped to.
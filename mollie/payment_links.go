@@ -2,7 +2,6 @@ package mollie
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"time"
 )
@@ -13,6 +12,7 @@ import (
 //
 // See: https://docs.mollie.com/reference/v2/payment-links-api/get-payment-link
 type PaymentLink struct {
+	Archived    bool             `json:"archived,omitempty"`
 	ID          string           `json:"id,omitempty"`
 	Resource    string           `json:"resource,omitempty"`
 	Description string           `json:"description,omitempty"`
@@ -59,7 +59,8 @@ type PaymentLinksList struct {
 }
 
 // UpdatePaymentLinks describes certain details of an existing payment link
-// that can be updated.
+// that can be updated, set Archived to deactivate the link so it can no
+// longer be paid.
 type UpdatePaymentLinks struct {
 	Description string `json:"description,omitempty"`
 	Archived    bool   `json:"archived,omitempty"`
@@ -77,7 +78,7 @@ func (pls *PaymentLinksService) Get(ctx context.Context, id string) (res *Respon
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &pl); err != nil {
+	if err = res.decode(&pl); err != nil {
 		return
 	}
 
@@ -87,17 +88,22 @@ func (pls *PaymentLinksService) Get(ctx context.Context, id string) (res *Respon
 // Create generates payment links that by default, unlike regular payments, do not expire.
 //
 // See: https://docs.mollie.com/reference/v2/payment-links-api/create-payment-link
-func (pls *PaymentLinksService) Create(ctx context.Context, p PaymentLink, opts *PaymentLinkOptions) (
+func (pls *PaymentLinksService) Create(
+	ctx context.Context,
+	p PaymentLink,
+	opts *PaymentLinkOptions,
+	reqOpts ...RequestOption,
+) (
 	res *Response,
 	np *PaymentLink,
 	err error,
 ) {
-	res, err = pls.client.post(ctx, "v2/payment-links", p, opts)
+	res, err = pls.client.post(ctx, "v2/payment-links", p, opts, reqOpts...)
 	if err != nil {
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &np); err != nil {
+	if err = res.decode(&np); err != nil {
 		return
 	}
 
@@ -118,7 +124,7 @@ func (pls *PaymentLinksService) List(ctx context.Context, opts *PaymentLinkOptio
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &pl); err != nil {
+	if err = res.decode(&pl); err != nil {
 		return
 	}
 
@@ -128,17 +134,17 @@ func (pls *PaymentLinksService) List(ctx context.Context, opts *PaymentLinkOptio
 // Update changes certain details of an existing payment link.
 //
 // See: https://docs.mollie.com/reference/update-payment-link
-func (pls *PaymentLinksService) Update(ctx context.Context, id string, p UpdatePaymentLinks) (
+func (pls *PaymentLinksService) Update(ctx context.Context, id string, p UpdatePaymentLinks, reqOpts ...RequestOption) (
 	res *Response,
 	pl *PaymentLink,
 	err error,
 ) {
-	res, err = pls.client.patch(ctx, fmt.Sprintf("v2/payment-links/%s", id), p)
+	res, err = pls.client.patch(ctx, fmt.Sprintf("v2/payment-links/%s", id), p, reqOpts...)
 	if err != nil {
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &pl); err != nil {
+	if err = res.decode(&pl); err != nil {
 		return
 	}
 
@@ -148,8 +154,12 @@ func (pls *PaymentLinksService) Update(ctx context.Context, id string, p UpdateP
 // Delete removes a payment link from the website profile.
 //
 // See: https://docs.mollie.com/reference/delete-payment-link
-func (pls *PaymentLinksService) Delete(ctx context.Context, id string) (res *Response, err error) {
-	res, err = pls.client.delete(ctx, fmt.Sprintf("v2/payment-links/%s", id))
+func (pls *PaymentLinksService) Delete(
+	ctx context.Context,
+	id string,
+	reqOpts ...RequestOption,
+) (res *Response, err error) {
+	res, err = pls.client.delete(ctx, fmt.Sprintf("v2/payment-links/%s", id), reqOpts...)
 	if err != nil {
 		return
 	}
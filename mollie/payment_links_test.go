@@ -68,7 +68,7 @@ func TestPaymentLinkService_Get(t *testing.T) {
 				nil,
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding PaymentLink: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -168,7 +168,7 @@ func TestPaymentLinkService_Create(t *testing.T) {
 				nil,
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding PaymentLink: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -283,7 +283,7 @@ func TestPaymentLinkService_List(t *testing.T) {
 				nil,
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding PaymentLinksList: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -381,7 +381,7 @@ func TestPaymentLinkService_Update(t *testing.T) {
 				UpdatePaymentLinks{},
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding PaymentLink: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -415,6 +415,7 @@ func TestPaymentLinkService_Update(t *testing.T) {
 				assert.Nil(t, err)
 				assert.IsType(t, &PaymentLink{}, m)
 				assert.IsType(t, &http.Response{}, res.Response)
+				assert.True(t, m.Archived)
 			}
 		})
 	}
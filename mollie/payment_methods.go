@@ -2,7 +2,6 @@ package mollie
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 )
 
@@ -21,6 +20,9 @@ const (
 )
 
 // PaymentMethodDetails describes a single method with details.
+//
+// Pricing and Issuers are only populated when requested through the
+// include query string parameter, otherwise they are nil.
 type PaymentMethodDetails struct {
 	Resource      string                  `json:"resource,omitempty"`
 	ID            string                  `json:"id,omitempty"`
@@ -73,13 +75,17 @@ type PaymentMethodOptions struct {
 	Locale    Locale         `url:"locale,omitempty"`
 	Currency  string         `url:"currency,omitempty"`
 	ProfileID string         `url:"profileId,omitempty"`
-	Include   []IncludeValue `url:"include,omitempty"`
+	Include   []IncludeValue `url:"include,omitempty,comma"`
 }
 
 // ListPaymentMethodsOptions are applicable query string parameters to list methods
 // from mollie's API.
 //
 // It contains list specific options and embeds GetMethodOptions.
+//
+// Locale takes precedence over the embedded PaymentMethodOptions.Locale,
+// only one of them is sent to Mollie. Amount filters the methods available
+// for the cart amount, it is encoded as amount[value] and amount[currency].
 type ListPaymentMethodsOptions struct {
 	PaymentMethodOptions
 	Resource            string                              `url:"resource,omitempty"`
@@ -91,6 +97,23 @@ type ListPaymentMethodsOptions struct {
 	SequenceType        SequenceType                        `url:"sequenceType,omitempty"`
 }
 
+// query returns a copy of the options where the locale is only set once,
+// otherwise both locale fields would be encoded in the query string.
+func (lpo *ListPaymentMethodsOptions) query() *ListPaymentMethodsOptions {
+	if lpo == nil {
+		return nil
+	}
+
+	q := *lpo
+	if q.Locale == "" {
+		q.Locale = q.PaymentMethodOptions.Locale
+	}
+
+	q.PaymentMethodOptions.Locale = ""
+
+	return &q
+}
+
 // PaymentMethodsService operates on methods endpoints.
 type PaymentMethodsService service
 
@@ -111,13 +134,57 @@ func (ms *PaymentMethodsService) Get(ctx context.Context, id PaymentMethod, opti
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &pmd); err != nil {
+	if err = res.decode(&pmd); err != nil {
+		return
+	}
+
+	return
+}
+
+// Issuers returns the issuers of the payment method specified by id, such as
+// the gift card brands available for GiftCard, the issuers are requested
+// through the include query string parameter on top of the given options.
+//
+// Methods without issuers return an empty list.
+//
+// See: https://docs.mollie.com/reference/v2/methods-api/get-method
+func (ms *PaymentMethodsService) Issuers(ctx context.Context, id PaymentMethod, options *PaymentMethodOptions) (
+	res *Response,
+	issuers []*PaymentMethodIssuer,
+	err error,
+) {
+	var opts PaymentMethodOptions
+	if options != nil {
+		opts = *options
+	}
+
+	opts.Include = append([]IncludeValue{IncludeIssuers}, withoutInclude(opts.Include, IncludeIssuers)...)
+
+	res, pmd, err := ms.Get(ctx, id, &opts)
+	if err != nil {
 		return
 	}
 
+	if pmd != nil {
+		issuers = pmd.Issuers
+	}
+
 	return
 }
 
+// withoutInclude returns a copy of includes without v.
+func withoutInclude(includes []IncludeValue, v IncludeValue) []IncludeValue {
+	out := make([]IncludeValue, 0, len(includes))
+
+	for _, i := range includes {
+		if i != v {
+			out = append(out, i)
+		}
+	}
+
+	return out
+}
+
 // All retrieves all the payment methods enabled for your account/organization.
 //
 // See: https://docs.mollie.com/reference/v2/methods-api/list-all-methods
@@ -126,7 +193,7 @@ func (ms *PaymentMethodsService) All(ctx context.Context, options *ListPaymentMe
 	pm *PaymentMethodsList,
 	err error,
 ) {
-	return ms.list(ctx, "v2/methods/all", options)
+	return ms.list(ctx, "v2/methods/all", options.query())
 }
 
 // List retrieves all enabled payment methods.
@@ -139,7 +206,7 @@ func (ms *PaymentMethodsService) List(ctx context.Context, options *ListPaymentM
 	pm *PaymentMethodsList,
 	err error,
 ) {
-	return ms.list(ctx, "v2/methods", options)
+	return ms.list(ctx, "v2/methods", options.query())
 }
 
 func (ms *PaymentMethodsService) list(ctx context.Context, uri string, options interface{}) (
@@ -152,7 +219,7 @@ func (ms *PaymentMethodsService) list(ctx context.Context, uri string, options i
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &pm); err != nil {
+	if err = res.decode(&pm); err != nil {
 		return
 	}
 
@@ -3,11 +3,13 @@ package mollie
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"net/http"
 	"testing"
 
 	"github.com/VictorAvelar/mollie-api-go/v4/testdata"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestMethodsService_List(t *testing.T) {
@@ -71,6 +73,45 @@ func TestMethodsService_List(t *testing.T) {
 				_, _ = w.Write([]byte(testdata.ListMethodsResponse))
 			},
 		},
+		{
+			"list methods sends the locale only once",
+			args{
+				context.Background(),
+				&ListPaymentMethodsOptions{
+					PaymentMethodOptions: PaymentMethodOptions{
+						Locale: Dutch,
+					},
+					Locale: English,
+				},
+			},
+			false,
+			nil,
+			noPre,
+			func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "GET")
+				testQuery(t, r, "locale=en_US")
+				_, _ = w.Write([]byte(testdata.ListMethodsResponse))
+			},
+		},
+		{
+			"list methods uses the embedded locale when no list locale is provided",
+			args{
+				context.Background(),
+				&ListPaymentMethodsOptions{
+					PaymentMethodOptions: PaymentMethodOptions{
+						Locale: Dutch,
+					},
+				},
+			},
+			false,
+			nil,
+			noPre,
+			func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "GET")
+				testQuery(t, r, "locale=nl_NL")
+				_, _ = w.Write([]byte(testdata.ListMethodsResponse))
+			},
+		},
 		{
 			"list methods, an error is returned from the server",
 			args{
@@ -89,7 +130,7 @@ func TestMethodsService_List(t *testing.T) {
 				nil,
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding PaymentMethodsList: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -206,7 +247,7 @@ func TestMethodsService_All(t *testing.T) {
 				nil,
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding PaymentMethodsList: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -325,7 +366,7 @@ func TestMethodsService_Get(t *testing.T) {
 				PayPal,
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding PaymentMethodDetails: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -362,3 +403,137 @@ func TestMethodsService_Get(t *testing.T) {
 		})
 	}
 }
+
+func TestMethodsService_Get_Includes(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+
+	cases := []struct {
+		name        string
+		options     *PaymentMethodOptions
+		query       string
+		response    string
+		wantIssuers bool
+		wantPricing bool
+	}{
+		{
+			"issuers and pricing are nil when not requested",
+			nil,
+			"",
+			testdata.GetMethodWithoutIncludesResponse,
+			false,
+			false,
+		},
+		{
+			"issuers and pricing are populated when requested",
+			&PaymentMethodOptions{
+				Include: []IncludeValue{IncludeIssuers, IncludePricing},
+			},
+			"include=issuers%2Cpricing",
+			testdata.GetMethodResponse,
+			true,
+			true,
+		},
+	}
+
+	for _, c := range cases {
+		setup()
+		defer teardown()
+		t.Run(c.name, func(t *testing.T) {
+			tMux.HandleFunc("/v2/methods/ideal", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "GET")
+				testQuery(t, r, c.query)
+				_, _ = w.Write([]byte(c.response))
+			})
+
+			_, m, err := tClient.PaymentMethods.Get(context.Background(), IDeal, c.options)
+			assert.Nil(t, err)
+
+			if c.wantIssuers {
+				assert.NotEmpty(t, m.Issuers)
+			} else {
+				assert.Nil(t, m.Issuers)
+			}
+
+			if c.wantPricing {
+				assert.Equal(t, "0.29", m.Pricing[0].Fixed.Value)
+			} else {
+				assert.Nil(t, m.Pricing)
+			}
+		})
+	}
+}
+
+func TestMethodsService_List_Filters(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	tMux.HandleFunc("/v2/methods", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(
+			t,
+			r,
+			"amount%5Bcurrency%5D=EUR&amount%5Bvalue%5D=25.00&billingCountry=NL&includeWallets=applepay"+
+				"&resource=orders&sequenceType=first",
+		)
+		_, _ = w.Write([]byte(testdata.ListMethodsResponse))
+	})
+
+	_, _, err := tClient.PaymentMethods.List(context.Background(), &ListPaymentMethodsOptions{
+		Amount:         NewAmount("EUR", big.NewRat(25, 1)),
+		SequenceType:   FirstSequence,
+		BillingCountry: "NL",
+		IncludeWallets: []Wallet{ApplePayWallet},
+		Resource:       "orders",
+	})
+	require.Nil(t, err)
+}
+
+func TestMethodsService_Issuers(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+
+	cases := []struct {
+		name    string
+		options *PaymentMethodOptions
+		query   string
+	}{
+		{
+			"issuers are requested without options",
+			nil,
+			"include=issuers",
+		},
+		{
+			"issuers are requested once along other includes",
+			&PaymentMethodOptions{
+				Locale:  Dutch,
+				Include: []IncludeValue{IncludePricing, IncludeIssuers},
+			},
+			"include=issuers%2Cpricing&locale=nl_NL",
+		},
+	}
+
+	for _, c := range cases {
+		setup()
+		defer teardown()
+		t.Run(c.name, func(t *testing.T) {
+			tMux.HandleFunc("/v2/methods/giftcard", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "GET")
+				testQuery(t, r, c.query)
+				_, _ = w.Write([]byte(testdata.GetGiftCardMethodWithIssuersResponse))
+			})
+
+			_, issuers, err := tClient.PaymentMethods.Issuers(context.Background(), GiftCard, c.options)
+			require.Nil(t, err)
+			require.Len(t, issuers, 2)
+			assert.Equal(t, string(Boekenbon), issuers[0].ID)
+			assert.Equal(t, string(Fashioncheque), issuers[1].ID)
+
+			if c.options != nil {
+				assert.Equal(t, []IncludeValue{IncludePricing, IncludeIssuers}, c.options.Include)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package mollie
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -33,6 +34,47 @@ const (
 	Voucher        PaymentMethod = "voucher"
 )
 
+// PaymentMethods restricts the payment methods offered to the customer.
+//
+// A single method is encoded as a string, which makes the checkout skip the
+// method selection, several methods are encoded as a list the customer picks
+// from. Both shapes are decoded.
+type PaymentMethods []PaymentMethod
+
+// MarshalJSON encodes a single method as a string and several as a list.
+func (pm PaymentMethods) MarshalJSON() ([]byte, error) {
+	if len(pm) == 1 {
+		return json.Marshal(pm[0])
+	}
+
+	return json.Marshal([]PaymentMethod(pm))
+}
+
+// UnmarshalJSON decodes either a single method or a list of methods.
+func (pm *PaymentMethods) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+
+	if len(data) > 0 && data[0] == '"' {
+		var m PaymentMethod
+		if err := json.Unmarshal(data, &m); err != nil {
+			return err
+		}
+
+		*pm = PaymentMethods{m}
+
+		return nil
+	}
+
+	var ms []PaymentMethod
+	if err := json.Unmarshal(data, &ms); err != nil {
+		return err
+	}
+
+	*pm = ms
+
+	return nil
+}
+
 // SequenceType indicates which type of payment this is in a recurring sequence.
 type SequenceType string
 
@@ -56,6 +98,26 @@ type PaymentDestination struct {
 	OrganizationID string `json:"organizationId,omitempty"`
 }
 
+// Route describes a part of a payment routed to a connected organization.
+type Route struct {
+	Resource    string             `json:"resource,omitempty"`
+	ID          string             `json:"id,omitempty"`
+	PaymentID   string             `json:"paymentId,omitempty"`
+	Amount      *Amount            `json:"amount,omitempty"`
+	Destination PaymentDestination `json:"destination,omitempty"`
+	ReleaseDate *ShortDate         `json:"releaseDate,omitempty"`
+	CreatedAt   *time.Time         `json:"createdAt,omitempty"`
+}
+
+// RoutesList describes a list of payment routes.
+type RoutesList struct {
+	Count    int `json:"count,omitempty"`
+	Embedded struct {
+		Routes []*Route `json:"routes,omitempty"`
+	} `json:"_embedded,omitempty"`
+	Links PaginationLinks `json:"_links,omitempty"`
+}
+
 // CreatePayment describes the payload to be sent to the Mollie API when
 // creating or updating a new payment.
 //
@@ -64,15 +126,15 @@ type PaymentDestination struct {
 //
 // See: https://docs.mollie.com/reference/v2/payments-api/create-payment#payment-method-specific-parameters
 type CreatePayment struct {
-	Description                     string          `json:"description,omitempty"`
-	RedirectURL                     string          `json:"redirectUrl,omitempty"`
-	CancelURL                       string          `json:"cancelUrl,omitempty"`
-	WebhookURL                      string          `json:"webhookUrl,omitempty"`
-	RestrictPaymentMethodsToCountry string          `json:"restrictPaymentMethodsToCountry,omitempty"`
-	Amount                          *Amount         `json:"amount,omitempty"`
-	Locale                          Locale          `json:"locale,omitempty"`
-	Method                          []PaymentMethod `json:"method,omitempty"`
-	Metadata                        any             `json:"metadata,omitempty"`
+	Description                     string         `json:"description,omitempty"`
+	RedirectURL                     string         `json:"redirectUrl,omitempty"`
+	CancelURL                       string         `json:"cancelUrl,omitempty"`
+	WebhookURL                      string         `json:"webhookUrl,omitempty"`
+	RestrictPaymentMethodsToCountry string         `json:"restrictPaymentMethodsToCountry,omitempty"`
+	Amount                          *Amount        `json:"amount,omitempty"`
+	Locale                          Locale         `json:"locale,omitempty"`
+	Method                          PaymentMethods `json:"method,omitempty"`
+	Metadata                        Metadata       `json:"metadata,omitempty"`
 
 	// Beta fields
 	Lines []PaymentLines `json:"lines,omitempty"`
@@ -171,20 +233,97 @@ type PaymentLines struct {
 	Type           PaymentLineType `json:"type,omitempty"`
 }
 
+// Validate checks the locale of the payment is well formed, its amount
+// is formatted with the decimals of the currency and the routed amounts
+// do not exceed it.
+func (cp *CreatePayment) Validate() error {
+	if err := validateLocale(cp.Locale); err != nil {
+		return err
+	}
+
+	if err := validateAmount("amount", cp.Amount); err != nil {
+		return err
+	}
+
+	if err := cp.validateIssuer(); err != nil {
+		return err
+	}
+
+	return cp.validateRouting()
+}
+
+// issuerMethods are the payment methods accepting an issuer on creation.
+var issuerMethods = []PaymentMethod{IDeal, KBC, CBC, GiftCard}
+
+// validateIssuer checks an issuer is only sent along a single method
+// supporting issuers, Mollie rejects the payment otherwise.
+func (cp *CreatePayment) validateIssuer() error {
+	if cp.Issuer == "" {
+		return nil
+	}
+
+	if len(cp.Method) != 1 {
+		return &ValidationError{Field: "issuer", Reason: "requires exactly one payment method"}
+	}
+
+	for _, m := range issuerMethods {
+		if cp.Method[0] == m {
+			return nil
+		}
+	}
+
+	return &ValidationError{
+		Field:  "issuer",
+		Reason: fmt.Sprintf("is not supported by payment method %s", cp.Method[0]),
+	}
+}
+
+// validateRouting checks the routed amounts add up to at most the payment amount.
+func (cp *CreatePayment) validateRouting() error {
+	if cp.Amount == nil || len(cp.Routing) == 0 {
+		return nil
+	}
+
+	sum := NewAmount(cp.Amount.Currency, nil)
+
+	for i, r := range cp.Routing {
+		if r == nil || r.Amount == nil {
+			return &ValidationError{Field: fmt.Sprintf("routing[%d].amount", i), Reason: "is required"}
+		}
+
+		var err error
+		if sum, err = sum.Add(r.Amount); err != nil {
+			return &ValidationError{Field: fmt.Sprintf("routing[%d].amount", i), Reason: err.Error()}
+		}
+	}
+
+	if over, err := sum.GreaterThan(cp.Amount); err != nil || over {
+		return &ValidationError{
+			Field:  "routing",
+			Reason: fmt.Sprintf("routed amounts %s must not exceed the payment amount %s", sum, cp.Amount),
+		}
+	}
+
+	return nil
+}
+
 // UpdatePayment describes the payload to be sent to the Mollie API when
 // updating a payment.
 //
+// Empty fields are left untouched, the webhook URL is cleared using
+// Null[string]() and the metadata using NullMetadata().
+//
 // See: https://docs.mollie.com/reference/v2/payments-api/update-payment
 // See: https://docs.mollie.com/reference/v2/payments-api/update-payment#payment-method-specific-parameters
 type UpdatePayment struct {
-	Description                     string        `json:"description,omitempty"`
-	RedirectURL                     string        `json:"redirectUrl,omitempty"`
-	CancelURL                       string        `json:"cancelUrl,omitempty"`
-	WebhookURL                      string        `json:"webhookUrl,omitempty"`
-	Metadata                        any           `json:"metadata,omitempty"`
-	Method                          PaymentMethod `json:"method,omitempty"`
-	Locale                          Locale        `json:"locale,omitempty"`
-	RestrictPaymentMethodsToCountry string        `json:"restrictPaymentMethodsToCountry,omitempty"`
+	Description                     string            `json:"description,omitempty"`
+	RedirectURL                     string            `json:"redirectUrl,omitempty"`
+	CancelURL                       string            `json:"cancelUrl,omitempty"`
+	WebhookURL                      *Nullable[string] `json:"webhookUrl,omitempty"`
+	Metadata                        Metadata          `json:"metadata,omitempty"`
+	Method                          PaymentMethod     `json:"method,omitempty"`
+	Locale                          Locale            `json:"locale,omitempty"`
+	RestrictPaymentMethodsToCountry string            `json:"restrictPaymentMethodsToCountry,omitempty"`
 
 	// PaymentMethods specific fields
 	BillingEmail string     `json:"billingEmail,omitempty"`
@@ -192,12 +331,54 @@ type UpdatePayment struct {
 	Issuer       string     `json:"issuer,omitempty"`
 }
 
+// Validate checks the locale of the payment is well formed.
+func (up *UpdatePayment) Validate() error {
+	return validateLocale(up.Locale)
+}
+
+// PaymentStatus describes the status of a payment.
+type PaymentStatus string
+
+// Valid payment status.
+const (
+	PaymentStatusOpen       PaymentStatus = "open"
+	PaymentStatusPending    PaymentStatus = "pending"
+	PaymentStatusAuthorized PaymentStatus = "authorized"
+	PaymentStatusPaid       PaymentStatus = "paid"
+	PaymentStatusCanceled   PaymentStatus = "canceled"
+	PaymentStatusExpired    PaymentStatus = "expired"
+	PaymentStatusFailed     PaymentStatus = "failed"
+)
+
+// in reports whether the status is one of statuses.
+func (ps PaymentStatus) in(statuses []PaymentStatus) bool {
+	for _, s := range statuses {
+		if ps == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsFinal reports whether the payment reached a status that will not change anymore,
+// paid payments can still be refunded or charged back.
+func (ps PaymentStatus) IsFinal() bool {
+	switch ps {
+	case PaymentStatusPaid, PaymentStatusCanceled, PaymentStatusExpired, PaymentStatusFailed:
+		return true
+	default:
+		return false
+	}
+}
+
 // Payment describes a transaction between a customer and a merchant.
 type Payment struct {
 	Resource                        string        `json:"resource,omitempty"`
 	ID                              string        `json:"id,omitempty"`
-	Status                          string        `json:"status,omitempty"`
+	Status                          PaymentStatus `json:"status,omitempty"`
 	Description                     string        `json:"description,omitempty"`
+	RedirectURL                     string        `json:"redirectUrl,omitempty"`
 	CancelURL                       string        `json:"cancelUrl,omitempty"`
 	WebhookURL                      string        `json:"webhookUrl,omitempty"`
 	CountryCode                     string        `json:"countryCode,omitempty"`
@@ -209,7 +390,7 @@ type Payment struct {
 	Mode                            Mode          `json:"mode,omitempty"`
 	Locale                          Locale        `json:"locale,omitempty"`
 	Method                          PaymentMethod `json:"method,omitempty"`
-	Metadata                        any           `json:"metadata,omitempty"`
+	Metadata                        Metadata      `json:"metadata,omitempty"`
 	Links                           PaymentLinks  `json:"_links,omitempty"`
 	CreatedAt                       *time.Time    `json:"createdAt,omitempty"`
 	AuthorizedAt                    *time.Time    `json:"authorizedAt,omitempty"`
@@ -231,6 +412,13 @@ type Payment struct {
 	// PaymentMethods specific fields
 	Details PaymentDetails `json:"details,omitempty"`
 
+	// Embedded resources, only populated when requested using PaymentOptions.Embed.
+	Embedded struct {
+		Refunds     []*Refund     `json:"refunds,omitempty"`
+		Chargebacks []*Chargeback `json:"chargebacks,omitempty"`
+		Captures    []*Capture    `json:"captures,omitempty"`
+	} `json:"_embedded,omitempty"`
+
 	// Other case specific fields
 	RecurrentPaymentFields
 	PreAuthorizedPaymentFields
@@ -265,6 +453,9 @@ type AccessTokenPaymentFields struct {
 
 // PaymentLinks describes all the possible links to be returned with
 // a payment object.
+//
+// Status and PayOnline are only returned for bank transfer payments, they
+// point to the pages showing the transfer instructions and status.
 type PaymentLinks struct {
 	Self               *URL `json:"self,omitempty"`
 	Checkout           *URL `json:"checkout,omitempty"`
@@ -281,23 +472,61 @@ type PaymentLinks struct {
 	Dashboard          *URL `json:"dashboard,omitempty"`
 	MobileAppCheckout  *URL `json:"mobileAppCheckout,omitempty"`
 	Terminal           *URL `json:"terminal,omitempty"`
+	Status             *URL `json:"status,omitempty"`
+	PayOnline          *URL `json:"payOnline,omitempty"`
+	extra              *Links
+}
+
+// UnmarshalJSON decodes the links, the ones not described by PaymentLinks
+// remain available through Link.
+func (l *PaymentLinks) UnmarshalJSON(data []byte) (err error) {
+	l.extra, err = unmarshalLinks(data, l)
+
+	return err
+}
+
+// Link returns the link with the given name, e.g. "checkout".
+func (l *PaymentLinks) Link(name string) *URL {
+	return lookupLink(l, l.extra, name)
+}
+
+// Link returns the link of the payment with the given name, e.g. "checkout".
+func (p *Payment) Link(name string) *URL {
+	if p == nil {
+		return nil
+	}
+
+	return p.Links.Link(name)
+}
+
+// CheckoutURL returns the url the customer is redirected to in order to pay,
+// false when the payment has no checkout link, e.g. once it has been paid.
+func (p *Payment) CheckoutURL() (string, bool) {
+	if p == nil || p.Links.Checkout.String() == "" {
+		return "", false
+	}
+
+	return p.Links.Checkout.Href, true
 }
 
 // PaymentOptions describes payments endpoint valid query string parameters.
 //
 // See: https://docs.mollie.com/reference/v2/payments-api/get-payment
 type PaymentOptions struct {
-	Include []IncludeValue `url:"include,omitempty"`
-	Embed   []EmbedValue   `url:"embed,omitempty"`
+	Include  []IncludeValue `url:"include,omitempty,comma"`
+	Embed    []EmbedValue   `url:"embed,omitempty,comma"`
+	Testmode bool           `url:"testmode,omitempty"`
 }
 
 // ListPaymentsOptions describes list payments endpoint valid query string parameters.
 type ListPaymentsOptions struct {
 	Limit     int            `url:"limit,omitempty"`
-	Include   []IncludeValue `url:"include,omitempty"`
-	Embed     []EmbedValue   `url:"embed,omitempty"`
+	Include   []IncludeValue `url:"include,omitempty,comma"`
+	Embed     []EmbedValue   `url:"embed,omitempty,comma"`
 	ProfileID string         `url:"profileId,omitempty"`
 	From      string         `url:"from,omitempty"`
+	Sort      SortDirection  `url:"sort,omitempty"`
+	Testmode  bool           `url:"testmode,omitempty"`
 }
 
 // PaymentsService instance operates over payment resources.
@@ -314,17 +543,59 @@ func (ps *PaymentsService) Get(ctx context.Context, id string, opts *PaymentOpti
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &p); err != nil {
+	if err = res.decode(&p); err != nil {
 		return
 	}
 
 	return
 }
 
+// WaitForStatus retrieves the payment until its status is one of the targets
+// or a final status is reached, e.g. after redirecting the customer back from
+// the checkout. Without targets it waits for a final status.
+//
+// The payment is polled with a capped exponential backoff configured using
+// Client.WithPollInterval. The last retrieved payment is always returned, also
+// along with the error when a retrieval fails or the context is done, so the
+// caller can inspect it. Reaching a final status other than the targets is
+// not an error, the status of the returned payment tells which one it is.
+func (ps *PaymentsService) WaitForStatus(ctx context.Context, id string, target ...PaymentStatus) (*Payment, error) {
+	var last *Payment
+
+	for attempt := 0; ; attempt++ {
+		_, p, err := ps.Get(ctx, id, nil)
+		if err != nil {
+			return last, err
+		}
+
+		if p != nil {
+			last = p
+
+			if p.Status.IsFinal() || p.Status.in(target) {
+				return p, nil
+			}
+		}
+
+		if err := sleep(ctx, ps.client.poll.delay(attempt)); err != nil {
+			return last, err
+		}
+	}
+}
+
 // Create stores a new payment object attached to your Mollie account.
 //
+// Payments created using an organization or OAuth access token must tell the
+// profile they belong to using ProfileID, a *ValidationError is returned
+// without sending the request when it is missing, or when it is provided while
+// using an API key, see Client.WithProfileScopeValidation.
+//
 // See: https://docs.mollie.com/reference/v2/payments-api/create-payment#
-func (ps *PaymentsService) Create(ctx context.Context, p CreatePayment, opts *PaymentOptions) (
+func (ps *PaymentsService) Create(
+	ctx context.Context,
+	p CreatePayment,
+	opts *PaymentOptions,
+	reqOpts ...RequestOption,
+) (
 	res *Response,
 	np *Payment,
 	err error,
@@ -333,28 +604,64 @@ func (ps *PaymentsService) Create(ctx context.Context, p CreatePayment, opts *Pa
 		p.Testmode = true
 	}
 
-	res, err = ps.client.post(ctx, "v2/payments", p, opts)
+	if err = p.Validate(); err != nil {
+		return
+	}
+
+	if err = ps.client.validateCreateProfile(p.ProfileID); err != nil {
+		return
+	}
+
+	res, err = ps.client.post(ctx, "v2/payments", p, opts, reqOpts...)
 	if err != nil {
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &np); err != nil {
+	if err = res.decode(&np); err != nil {
 		return
 	}
 
 	return
 }
 
+// CreateGiftCard stores a new gift card payment paid with the gift card of
+// the given issuer, the issuers available to the profile are listed by
+// PaymentMethodsService.Issuers.
+//
+// The method and issuer of p are replaced, see Create for the rest of the flow.
+//
+// See: https://docs.mollie.com/reference/v2/payments-api/create-payment#gift-cards
+func (ps *PaymentsService) CreateGiftCard(
+	ctx context.Context,
+	p CreatePayment,
+	issuer GiftCardIssuer,
+	opts *PaymentOptions,
+	reqOpts ...RequestOption,
+) (
+	res *Response,
+	np *Payment,
+	err error,
+) {
+	p.Method = PaymentMethods{GiftCard}
+	p.Issuer = string(issuer)
+
+	return ps.Create(ctx, p, opts, reqOpts...)
+}
+
 // Cancel removes a payment (if possible) from your Mollie account.
 //
 // See: https://docs.mollie.com/reference/v2/payments-api/cancel-payment
-func (ps *PaymentsService) Cancel(ctx context.Context, id string) (res *Response, p *Payment, err error) {
-	res, err = ps.client.delete(ctx, fmt.Sprintf("v2/payments/%s", id))
+func (ps *PaymentsService) Cancel(
+	ctx context.Context,
+	id string,
+	reqOpts ...RequestOption,
+) (res *Response, p *Payment, err error) {
+	res, err = ps.client.delete(ctx, fmt.Sprintf("v2/payments/%s", id), reqOpts...)
 	if err != nil {
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &p); err != nil {
+	if err = res.decodeOptional(&p); err != nil {
 		return
 	}
 
@@ -364,17 +671,21 @@ func (ps *PaymentsService) Cancel(ctx context.Context, id string) (res *Response
 // Update can be used to update some details of a created payment.
 //
 // See: https://docs.mollie.com/reference/v2/payments-api/update-payment
-func (ps *PaymentsService) Update(ctx context.Context, id string, up UpdatePayment) (
+func (ps *PaymentsService) Update(ctx context.Context, id string, up UpdatePayment, reqOpts ...RequestOption) (
 	res *Response,
 	p *Payment,
 	err error,
 ) {
-	res, err = ps.client.patch(ctx, fmt.Sprintf("v2/payments/%s", id), up)
+	if err = up.Validate(); err != nil {
+		return
+	}
+
+	res, err = ps.client.patch(ctx, fmt.Sprintf("v2/payments/%s", id), up, reqOpts...)
 	if err != nil {
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &p); err != nil {
+	if err = res.decode(&p); err != nil {
 		return
 	}
 
@@ -403,7 +714,27 @@ func (ps *PaymentsService) List(ctx context.Context, opts *ListPaymentsOptions)
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &pl); err != nil {
+	if err = res.decode(&pl); err != nil {
+		return
+	}
+
+	return
+}
+
+// ListRoutes retrieves the routes of a payment created using Mollie Connect.
+//
+// See: https://docs.mollie.com/reference/v2/payments-api/list-payment-routes
+func (ps *PaymentsService) ListRoutes(ctx context.Context, id string) (
+	res *Response,
+	rl *RoutesList,
+	err error,
+) {
+	res, err = ps.client.get(ctx, fmt.Sprintf("v2/payments/%s/routes", id), nil)
+	if err != nil {
+		return
+	}
+
+	if err = res.decode(&rl); err != nil {
 		return
 	}
 
@@ -2,12 +2,17 @@ package mollie
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/VictorAvelar/mollie-api-go/v4/testdata"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestPaymentsService_Get(t *testing.T) {
@@ -70,7 +75,7 @@ func TestPaymentsService_Get(t *testing.T) {
 				nil,
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding Payment: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -103,6 +108,7 @@ func TestPaymentsService_Get(t *testing.T) {
 			} else {
 				assert.Nil(t, err)
 				assert.IsType(t, &Payment{}, m)
+				assert.Equal(t, "https://webshop.example.org/order/12345/", m.RedirectURL)
 				assert.IsType(t, &http.Response{}, res.Response)
 			}
 		})
@@ -165,7 +171,7 @@ func TestPaymentsService_List(t *testing.T) {
 				nil,
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding PaymentList: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -203,6 +209,64 @@ func TestPaymentsService_List(t *testing.T) {
 	}
 }
 
+func TestPaymentsService_Get_Embedded(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+
+	cases := []struct {
+		name    string
+		options *PaymentOptions
+		query   string
+		content string
+		embeds  bool
+	}{
+		{
+			"embedded resources are decoded when requested",
+			&PaymentOptions{Embed: []EmbedValue{EmbedRefunds, EmbedChargebacks, EmbedCaptures}},
+			"embed=refunds%2Cchargebacks%2Ccaptures",
+			testdata.GetPaymentWithEmbeddedResourcesResponse,
+			true,
+		},
+		{
+			"embedded resources stay nil without embed options",
+			nil,
+			"",
+			testdata.GetPaymentResponse,
+			false,
+		},
+	}
+
+	for _, c := range cases {
+		setup()
+		defer teardown()
+
+		t.Run(c.name, func(t *testing.T) {
+			tMux.HandleFunc("/v2/payments/tr_WDqYK6vllg", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "GET")
+				testQuery(t, r, c.query)
+				_, _ = w.Write([]byte(c.content))
+			})
+
+			_, p, err := tClient.Payments.Get(context.Background(), "tr_WDqYK6vllg", c.options)
+			require.Nil(t, err)
+
+			if !c.embeds {
+				assert.Nil(t, p.Embedded.Refunds)
+				assert.Nil(t, p.Embedded.Chargebacks)
+				assert.Nil(t, p.Embedded.Captures)
+				return
+			}
+
+			require.Len(t, p.Embedded.Refunds, 1)
+			require.Len(t, p.Embedded.Chargebacks, 1)
+			require.Len(t, p.Embedded.Captures, 1)
+			assert.Equal(t, "re_4qqhO89gsT", p.Embedded.Refunds[0].ID)
+			assert.Equal(t, "chb_n9z0tp", p.Embedded.Chargebacks[0].ID)
+			assert.Equal(t, "cpt_4qqhO89gsT", p.Embedded.Captures[0].ID)
+		})
+	}
+}
+
 func TestPaymentsService_Create(t *testing.T) {
 	setEnv()
 	defer unsetEnv()
@@ -250,6 +314,9 @@ func TestPaymentsService_Create(t *testing.T) {
 				context.Background(),
 				CreatePayment{
 					BillingEmail: "test@example.com",
+					CreatePaymentAccessTokenFields: CreatePaymentAccessTokenFields{
+						ProfileID: "pfl_3RkSN1zuPE",
+					},
 				},
 				&PaymentOptions{
 					Include: []IncludeValue{},
@@ -289,7 +356,7 @@ func TestPaymentsService_Create(t *testing.T) {
 				nil,
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding Payment: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -412,7 +479,7 @@ func TestPaymentsService_Update(t *testing.T) {
 				UpdatePayment{},
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding Payment: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -504,7 +571,7 @@ func TestPaymentsService_Cancel(t *testing.T) {
 				"tr_WDqYK6vllg",
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding Payment: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -541,3 +608,428 @@ func TestPaymentsService_Cancel(t *testing.T) {
 		})
 	}
 }
+
+func TestPaymentStatus_IsFinal(t *testing.T) {
+	final := map[PaymentStatus]bool{
+		PaymentStatusOpen:       false,
+		PaymentStatusPending:    false,
+		PaymentStatusAuthorized: false,
+		PaymentStatusPaid:       true,
+		PaymentStatusCanceled:   true,
+		PaymentStatusExpired:    true,
+		PaymentStatusFailed:     true,
+	}
+
+	for status, want := range final {
+		assert.Equal(t, want, status.IsFinal(), status)
+	}
+}
+
+func TestPaymentsService_Get_QRCode(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	tMux.HandleFunc("/v2/payments/tr_WDqYK6vllg", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, "include=details.qrCode")
+		_, _ = w.Write([]byte(testdata.GetPaymentWithQRCodeResponse))
+	})
+
+	_, p, err := tClient.Payments.Get(context.Background(), "tr_WDqYK6vllg", &PaymentOptions{
+		Include: []IncludeValue{IncludeQrCode},
+	})
+	require.Nil(t, err)
+
+	want := &QRCode{Height: 180, Width: 180, Src: "https://www.mollie.com/qr/12345678.png"}
+	assert.Equal(t, want, p.Details.QRCode)
+
+	var details struct {
+		QRCode *QRCode `json:"qrCode"`
+	}
+	require.Nil(t, p.DetailsAs(&details))
+	assert.Equal(t, want, details.QRCode)
+}
+
+func TestPayment_DetailsAs(t *testing.T) {
+	var p Payment
+	assert.ErrorIs(t, p.DetailsAs(&struct{}{}), ErrPaymentWithoutDetails)
+
+	var np *Payment
+	assert.ErrorIs(t, np.DetailsAs(&struct{}{}), ErrPaymentWithoutDetails)
+
+	require.Nil(t, json.Unmarshal([]byte(`{"details":{"bankName":"ING","unknownField":"value"}}`), &p))
+	assert.Equal(t, "ING", p.Details.BankName)
+
+	var custom struct {
+		UnknownField string `json:"unknownField"`
+	}
+	require.Nil(t, p.DetailsAs(&custom))
+	assert.Equal(t, "value", custom.UnknownField)
+}
+
+func TestPaymentsService_Create_InvalidLocale(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	tMux.HandleFunc("/v2/payments", func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("payments with an invalid locale must not be sent")
+	})
+
+	_, p, err := tClient.Payments.Create(context.Background(), CreatePayment{Locale: "en-US"}, nil)
+	assert.Nil(t, p)
+	assert.EqualError(t, err, `validation_error: locale "en-US" is not a valid locale, expected a language and country code like en_US`)
+}
+
+func TestPayment_CheckoutURL(t *testing.T) {
+	var p Payment
+
+	require.Nil(t, json.Unmarshal([]byte(testdata.GetPaymentResponse), &p))
+
+	href, ok := p.CheckoutURL()
+	assert.True(t, ok)
+	assert.Equal(t, "https://www.mollie.com/payscreen/select-method/WDqYK6vllg", href)
+
+	href, ok = (&Payment{}).CheckoutURL()
+	assert.False(t, ok)
+	assert.Empty(t, href)
+
+	var np *Payment
+	_, ok = np.CheckoutURL()
+	assert.False(t, ok)
+}
+
+func TestPaymentsService_ListRoutes(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	tMux.HandleFunc("/v2/payments/tr_WDqYK6vllg/routes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = w.Write([]byte(testdata.ListPaymentRoutesResponse))
+	})
+
+	_, rl, err := tClient.Payments.ListRoutes(context.Background(), "tr_WDqYK6vllg")
+	require.Nil(t, err)
+	require.Len(t, rl.Embedded.Routes, 1)
+
+	route := rl.Embedded.Routes[0]
+	assert.Equal(t, "rt_9dk4al1n", route.ID)
+	assert.Equal(t, &Amount{Value: "7.50", Currency: "EUR"}, route.Amount)
+	assert.Equal(t, PaymentDestination{Kind: "organization", OrganizationID: "org_23456"}, route.Destination)
+	assert.NotNil(t, route.ReleaseDate)
+}
+
+func TestCreatePayment_ValidateRouting(t *testing.T) {
+	route := func(value string) *PaymentRouting {
+		return &PaymentRouting{
+			Amount:      &Amount{Currency: "EUR", Value: value},
+			Destination: PaymentDestination{Kind: "organization", OrganizationID: "org_23456"},
+		}
+	}
+
+	cases := []struct {
+		name    string
+		routing []*PaymentRouting
+		err     string
+	}{
+		{"payments without routing are accepted", nil, ""},
+		{"routes below the amount are accepted", []*PaymentRouting{route("2.50"), route("5.00")}, ""},
+		{"routes matching the amount are accepted", []*PaymentRouting{route("4.00"), route("6.00")}, ""},
+		{
+			"routes exceeding the amount are rejected",
+			[]*PaymentRouting{route("6.00"), route("4.01")},
+			"validation_error: routing routed amounts 10.01 EUR must not exceed the payment amount 10.00 EUR",
+		},
+		{
+			"routes without amount are rejected",
+			[]*PaymentRouting{{Destination: PaymentDestination{Kind: "organization"}}},
+			"validation_error: routing[0].amount is required",
+		},
+		{
+			"routes in another currency are rejected",
+			[]*PaymentRouting{{Amount: &Amount{Currency: "USD", Value: "1.00"}}},
+			"validation_error: routing[0].amount " + ErrCurrencyMismatch.Error() + ": EUR and USD",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			p := CreatePayment{Amount: &Amount{Currency: "EUR", Value: "10.00"}}
+			p.Routing = c.routing
+
+			err := p.Validate()
+			if c.err == "" {
+				assert.Nil(t, err)
+
+				return
+			}
+
+			assert.EqualError(t, err, c.err)
+		})
+	}
+}
+
+func TestPaymentsService_WaitForStatus(t *testing.T) {
+	cases := []struct {
+		name     string
+		statuses []PaymentStatus
+		target   []PaymentStatus
+		want     PaymentStatus
+		calls    int32
+	}{
+		{
+			"waits for a final status without targets",
+			[]PaymentStatus{PaymentStatusOpen, PaymentStatusPending, PaymentStatusPaid},
+			nil,
+			PaymentStatusPaid,
+			3,
+		},
+		{
+			"stops at the first target",
+			[]PaymentStatus{PaymentStatusOpen, PaymentStatusAuthorized, PaymentStatusPaid},
+			[]PaymentStatus{PaymentStatusAuthorized},
+			PaymentStatusAuthorized,
+			2,
+		},
+		{
+			"stops at final statuses other than the targets",
+			[]PaymentStatus{PaymentStatusOpen, PaymentStatusExpired, PaymentStatusPaid},
+			[]PaymentStatus{PaymentStatusAuthorized},
+			PaymentStatusExpired,
+			2,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			setEnv()
+			defer unsetEnv()
+			setup()
+			defer teardown()
+
+			tClient.WithPollInterval(time.Millisecond, 2*time.Millisecond)
+
+			var calls int32
+
+			tMux.HandleFunc("/v2/payments/tr_WDqYK6vllg", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "GET")
+
+				status := c.statuses[atomic.AddInt32(&calls, 1)-1]
+				body := strings.Replace(testdata.GetPaymentResponse, `"status": "open"`, `"status": "`+string(status)+`"`, 1)
+				_, _ = w.Write([]byte(body))
+			})
+
+			p, err := tClient.Payments.WaitForStatus(context.Background(), "tr_WDqYK6vllg", c.target...)
+			require.Nil(t, err)
+			assert.Equal(t, c.want, p.Status)
+			assert.Equal(t, c.calls, atomic.LoadInt32(&calls))
+		})
+	}
+}
+
+func TestPaymentsService_WaitForStatus_ContextDone(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	tClient.WithPollInterval(time.Hour, time.Hour)
+
+	tMux.HandleFunc("/v2/payments/tr_WDqYK6vllg", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(testdata.GetPaymentResponse))
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	p, err := tClient.Payments.WaitForStatus(ctx, "tr_WDqYK6vllg")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	require.NotNil(t, p)
+	assert.Equal(t, PaymentStatusOpen, p.Status)
+}
+
+func TestPaymentsService_Create_ProfileID(t *testing.T) {
+	cases := []struct {
+		name      string
+		auth      string
+		profileID string
+		skip      bool
+		wantErr   bool
+	}{
+		{"access tokens require a profile id", "access_token_test", "", false, true},
+		{"access tokens with a profile id are sent", "access_token_test", "pfl_3RkSN1zuPE", false, false},
+		{"api keys with a profile id are rejected", "test_dHar4XY7LxsDOtmnkVtjNVWXLSlXsM", "pfl_3RkSN1zuPE", false, true},
+		{"api keys without profile id are sent", "test_dHar4XY7LxsDOtmnkVtjNVWXLSlXsM", "", false, false},
+		{"the validation can be disabled", "access_token_test", "", true, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			setEnv()
+			defer unsetEnv()
+			setup()
+			defer teardown()
+
+			var sent bool
+
+			tMux.HandleFunc("/v2/payments", func(w http.ResponseWriter, r *http.Request) {
+				sent = true
+
+				var body map[string]any
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+				if c.profileID != "" {
+					assert.Equal(t, c.profileID, body["profileId"])
+				} else {
+					assert.NotContains(t, body, "profileId")
+				}
+
+				_, _ = w.Write([]byte(testdata.GetPaymentResponse))
+			})
+
+			require.NoError(t, tClient.WithAuthenticationValue(c.auth))
+			tClient.WithProfileScopeValidation(!c.skip)
+
+			p := CreatePayment{}
+			p.ProfileID = c.profileID
+
+			_, _, err := tClient.Payments.Create(context.Background(), p, nil)
+			if !c.wantErr {
+				assert.Nil(t, err)
+				assert.True(t, sent)
+
+				return
+			}
+
+			var ve *ValidationError
+			require.ErrorAs(t, err, &ve)
+			assert.Equal(t, "profileId", ve.Field)
+			assert.False(t, sent)
+		})
+	}
+}
+
+func TestPaymentMethods_JSON(t *testing.T) {
+	cases := []struct {
+		name    string
+		methods PaymentMethods
+		want    string
+	}{
+		{"a single method is encoded as a string", PaymentMethods{IDeal}, `{"method":"ideal"}`},
+		{"several methods are encoded as a list", PaymentMethods{IDeal, CreditCard}, `{"method":["ideal","creditcard"]}`},
+		{"no methods are omitted", nil, `{}`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := json.Marshal(CreatePayment{Method: c.methods})
+			require.NoError(t, err)
+			assert.JSONEq(t, c.want, string(got))
+
+			var back CreatePayment
+			require.NoError(t, json.Unmarshal(got, &back))
+			assert.Equal(t, c.methods, back.Method)
+		})
+	}
+}
+
+func TestPaymentsService_Create_MethodRestriction(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	tMux.HandleFunc("/v2/payments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "https://example.com/cart", body["cancelUrl"])
+		assert.Equal(t, "ideal", body["method"])
+
+		_, _ = w.Write([]byte(testdata.GetPaymentResponse))
+	})
+
+	_, _, err := tClient.Payments.Create(context.Background(), CreatePayment{
+		CancelURL: "https://example.com/cart",
+		Method:    PaymentMethods{IDeal},
+	}, nil)
+	require.Nil(t, err)
+}
+
+func TestCreatePayment_ValidateIssuer(t *testing.T) {
+	cases := []struct {
+		name    string
+		methods PaymentMethods
+		issuer  string
+		err     string
+	}{
+		{"payments without issuer are accepted", nil, "", ""},
+		{"gift card issuers are accepted", PaymentMethods{GiftCard}, string(Boekenbon), ""},
+		{"ideal issuers are accepted", PaymentMethods{IDeal}, "ideal_ABNANL2A", ""},
+		{
+			"issuers without method are rejected",
+			nil,
+			string(Boekenbon),
+			"validation_error: issuer requires exactly one payment method",
+		},
+		{
+			"issuers along several methods are rejected",
+			PaymentMethods{GiftCard, IDeal},
+			string(Boekenbon),
+			"validation_error: issuer requires exactly one payment method",
+		},
+		{
+			"issuers for methods without issuers are rejected",
+			PaymentMethods{CreditCard},
+			string(Boekenbon),
+			"validation_error: issuer is not supported by payment method creditcard",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			p := CreatePayment{Method: c.methods, Issuer: c.issuer}
+
+			err := p.Validate()
+			if c.err == "" {
+				assert.Nil(t, err)
+
+				return
+			}
+
+			assert.EqualError(t, err, c.err)
+		})
+	}
+}
+
+func TestPaymentsService_CreateGiftCard(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	tMux.HandleFunc("/v2/payments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "giftcard", body["method"])
+		assert.Equal(t, "fashioncheque", body["issuer"])
+
+		_, _ = w.Write([]byte(testdata.GetPaymentResponse))
+	})
+
+	_, p, err := tClient.Payments.CreateGiftCard(context.Background(), CreatePayment{
+		Amount:      &Amount{Currency: "EUR", Value: "25.00"},
+		Description: "Gift card order",
+		Method:      PaymentMethods{IDeal},
+	}, Fashioncheque, nil)
+	require.Nil(t, err)
+	assert.Equal(t, "tr_WDqYK6vllg", p.ID)
+}
@@ -2,7 +2,6 @@ package mollie
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 )
 
@@ -32,7 +31,7 @@ const (
 	OrganizationsRead  PermissionGrant = "organizations.read"
 	OrganizationsWrite PermissionGrant = "organizations.write"
 	OnboardingRead     PermissionGrant = "onboarding.read"
-	OnboardingWrite    PermissionGrant = "onbording.write"
+	OnboardingWrite    PermissionGrant = "onboarding.write"
 	PaymentLinksRead   PermissionGrant = "payment-links.read"
 	PaymentLinksWrite  PermissionGrant = "payment-links.write"
 	BalancesRead       PermissionGrant = "balances.read"
@@ -79,7 +78,7 @@ func (ps *PermissionsService) Get(ctx context.Context, id PermissionGrant) (res
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &p); err != nil {
+	if err = res.decode(&p); err != nil {
 		return
 	}
 
@@ -96,9 +95,20 @@ func (ps *PermissionsService) List(ctx context.Context) (res *Response, pl *Perm
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &pl); err != nil {
+	if err = res.decode(&pl); err != nil {
 		return
 	}
 
 	return
 }
+
+// Has reports whether the permission is granted to the current
+// access token, it is a shorthand for Get when only the grant matters.
+func (ps *PermissionsService) Has(ctx context.Context, id PermissionGrant) (bool, error) {
+	_, p, err := ps.Get(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	return p.Granted, nil
+}
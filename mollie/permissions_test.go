@@ -66,7 +66,7 @@ func TestPermissionsService_Get(t *testing.T) {
 				PaymentsWrite,
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding Permission: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -153,7 +153,7 @@ func TestPermissionsService_List(t *testing.T) {
 				context.Background(),
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding PermissionsList: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -190,3 +190,56 @@ func TestPermissionsService_List(t *testing.T) {
 		})
 	}
 }
+
+func TestPermissionsService_Has(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+
+	cases := []struct {
+		name    string
+		want    bool
+		wantErr bool
+		handler http.HandlerFunc
+	}{
+		{
+			"granted permissions are reported",
+			true,
+			false,
+			func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "GET")
+				_, _ = w.Write([]byte(testdata.GetPermissionsResponse))
+			},
+		},
+		{
+			"permissions that are not granted are reported",
+			false,
+			false,
+			func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"resource":"permission","id":"payments.read","granted":false}`))
+			},
+		},
+		{
+			"errors are returned",
+			false,
+			true,
+			errorHandler,
+		},
+	}
+
+	for _, c := range cases {
+		setup()
+		defer teardown()
+
+		t.Run(c.name, func(t *testing.T) {
+			tMux.HandleFunc("/v2/permissions/payments.read", c.handler)
+
+			ok, err := tClient.Permissions.Has(context.Background(), PaymentsRead)
+			if c.wantErr {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+			assert.Equal(t, c.want, ok)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package mollie
+
+import "time"
+
+// Default values used when polling a resource until it reaches a status.
+const (
+	DefaultPollInterval    time.Duration = time.Second
+	DefaultMaxPollInterval time.Duration = 30 * time.Second
+)
+
+// pollPolicy describes how often a resource is retrieved while waiting for
+// a status change.
+type pollPolicy struct {
+	interval    time.Duration
+	maxInterval time.Duration
+}
+
+// WithPollInterval configures how often the resources are retrieved by the
+// helpers waiting for a status change, e.g. PaymentsService.WaitForStatus.
+//
+// The resource is retrieved right away, then after waiting interval and
+// doubling the wait before every following retrieval, up to maxInterval. Non-positive values restore
+// DefaultPollInterval and DefaultMaxPollInterval, a maxInterval lower than
+// interval polls at a constant pace.
+func (c *Client) WithPollInterval(interval, maxInterval time.Duration) {
+	c.poll = pollPolicy{
+		interval:    interval,
+		maxInterval: maxInterval,
+	}
+}
+
+// delay returns the time to wait before the retrieval following attempt.
+func (pp pollPolicy) delay(attempt int) time.Duration {
+	base, limit := pp.interval, pp.maxInterval
+
+	if base <= 0 {
+		base = DefaultPollInterval
+	}
+
+	if limit <= 0 {
+		limit = DefaultMaxPollInterval
+	}
+
+	if limit < base {
+		return base
+	}
+
+	d := base << attempt
+	if d <= 0 || d > limit {
+		d = limit
+	}
+
+	return d
+}
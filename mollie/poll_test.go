@@ -0,0 +1,15 @@
+package mollie
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPollPolicy_delay(t *testing.T) {
+	assert.Equal(t, DefaultPollInterval, pollPolicy{}.delay(0))
+	assert.Equal(t, 8*time.Second, pollPolicy{}.delay(3))
+	assert.Equal(t, DefaultMaxPollInterval, pollPolicy{}.delay(10))
+	assert.Equal(t, 5*time.Second, pollPolicy{interval: 5 * time.Second, maxInterval: time.Second}.delay(4))
+}
@@ -2,9 +2,9 @@ package mollie
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 )
 
@@ -30,6 +30,17 @@ type CreateOrUpdateProfile struct {
 	Mode             Mode             `json:"mode,omitempty"`
 }
 
+// validateWebsite checks the website is an absolute https URL as Mollie
+// requires for new profiles.
+func (cp *CreateOrUpdateProfile) validateWebsite() error {
+	u, err := url.Parse(cp.Website)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return &ValidationError{Field: "website", Reason: "must be a valid https URL"}
+	}
+
+	return nil
+}
+
 // Profile will usually reflect the trademark or brand name
 // of the profile’s website or application.
 type Profile struct {
@@ -111,7 +122,7 @@ func (ps *ProfilesService) List(ctx context.Context, opts *ListProfilesOptions)
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &pl); err != nil {
+	if err = res.decode(&pl); err != nil {
 		return
 	}
 
@@ -135,7 +146,7 @@ func (ps *ProfilesService) get(ctx context.Context, id string) (res *Response, p
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &p); err != nil {
+	if err = res.decode(&p); err != nil {
 		return
 	}
 
@@ -143,17 +154,24 @@ func (ps *ProfilesService) get(ctx context.Context, id string) (res *Response, p
 }
 
 // Create stores a new profile in your Mollie account.
-func (ps *ProfilesService) Create(ctx context.Context, np CreateOrUpdateProfile) (
+//
+// The website must be a valid https URL, otherwise a *ValidationError
+// is returned without sending the request.
+func (ps *ProfilesService) Create(ctx context.Context, np CreateOrUpdateProfile, reqOpts ...RequestOption) (
 	res *Response,
 	p *Profile,
 	err error,
 ) {
-	res, err = ps.client.post(ctx, "v2/profiles", np, nil)
+	if err = np.validateWebsite(); err != nil {
+		return
+	}
+
+	res, err = ps.client.post(ctx, "v2/profiles", np, nil, reqOpts...)
 	if err != nil {
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &p); err != nil {
+	if err = res.decode(&p); err != nil {
 		return
 	}
 
@@ -161,17 +179,17 @@ func (ps *ProfilesService) Create(ctx context.Context, np CreateOrUpdateProfile)
 }
 
 // Update allows you to perform mutations on a profile.
-func (ps *ProfilesService) Update(ctx context.Context, id string, up CreateOrUpdateProfile) (
+func (ps *ProfilesService) Update(ctx context.Context, id string, up CreateOrUpdateProfile, reqOpts ...RequestOption) (
 	res *Response,
 	p *Profile,
 	err error,
 ) {
-	res, err = ps.client.patch(ctx, fmt.Sprintf("v2/profiles/%s", id), up)
+	res, err = ps.client.patch(ctx, fmt.Sprintf("v2/profiles/%s", id), up, reqOpts...)
 	if err != nil {
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &p); err != nil {
+	if err = res.decode(&p); err != nil {
 		return
 	}
 
@@ -180,8 +198,8 @@ func (ps *ProfilesService) Update(ctx context.Context, id string, up CreateOrUpd
 
 // Delete  enables profile deletions, rendering the profile unavailable
 // for further API calls and transactions.
-func (ps *ProfilesService) Delete(ctx context.Context, id string) (res *Response, err error) {
-	res, err = ps.client.delete(ctx, fmt.Sprintf("v2/profiles/%s", id))
+func (ps *ProfilesService) Delete(ctx context.Context, id string, reqOpts ...RequestOption) (res *Response, err error) {
+	res, err = ps.client.delete(ctx, fmt.Sprintf("v2/profiles/%s", id), reqOpts...)
 	if err != nil {
 		return
 	}
@@ -189,8 +207,11 @@ func (ps *ProfilesService) Delete(ctx context.Context, id string) (res *Response
 	return
 }
 
-// EnablePaymentMethod enables a payment method on a specific or authenticated profile.
+// EnablePaymentMethod enables a payment method on a specific or authenticated profile
+// and returns the activated method.
 // If you're using API tokens for authentication, pass "me" as id.
+//
+// See: https://docs.mollie.com/reference/v2/profiles-api/enable-method
 func (ps *ProfilesService) EnablePaymentMethod(ctx context.Context, id string, pm PaymentMethod) (
 	res *Response,
 	pmi *PaymentMethodDetails,
@@ -201,15 +222,18 @@ func (ps *ProfilesService) EnablePaymentMethod(ctx context.Context, id string, p
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &pmi); err != nil {
+	if err = res.decode(&pmi); err != nil {
 		return
 	}
 
 	return
 }
 
-// DisablePaymentMethod disables a payment method on a specific or authenticated profile.
+// DisablePaymentMethod disables a payment method on a specific or authenticated profile,
+// Mollie answers with an empty 204 No Content response on success.
 // If you're using API tokens for authentication, pass "me" as id.
+//
+// See: https://docs.mollie.com/reference/v2/profiles-api/disable-method
 func (ps *ProfilesService) DisablePaymentMethod(ctx context.Context, id string, pm PaymentMethod) (
 	res *Response,
 	err error,
@@ -236,7 +260,7 @@ func (ps *ProfilesService) EnableGiftCardIssuer(ctx context.Context, profileID s
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &gc); err != nil {
+	if err = res.decode(&gc); err != nil {
 		return
 	}
 
@@ -273,7 +297,7 @@ func (ps *ProfilesService) EnableGiftCardIssuerForCurrent(ctx context.Context, i
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &gc); err != nil {
+	if err = res.decode(&gc); err != nil {
 		return
 	}
 
@@ -315,7 +339,7 @@ func (ps *ProfilesService) EnableVoucherIssuer(
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &vc); err != nil {
+	if err = res.decode(&vc); err != nil {
 		return
 	}
 
@@ -350,7 +374,7 @@ func (ps *ProfilesService) EnableVoucherIssuerForCurrent(ctx context.Context, is
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &vc); err != nil {
+	if err = res.decode(&vc); err != nil {
 		return
 	}
 
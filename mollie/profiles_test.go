@@ -66,7 +66,7 @@ func TestProfilesService_Get(t *testing.T) {
 				"pfl_v9hTwCvYqw",
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding Profile: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -143,7 +143,7 @@ func TestProfilesService_GetCurrent(t *testing.T) {
 		{
 			"get current profile, an error occurs when parsing json",
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding Profile: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -257,7 +257,7 @@ func TestProfilesService_List(t *testing.T) {
 				&ListProfilesOptions{},
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding ProfilesList: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -316,7 +316,8 @@ func TestProfilesService_Create(t *testing.T) {
 			args{
 				context.Background(),
 				CreateOrUpdateProfile{
-					Name: "testing name",
+					Name:    "testing name",
+					Website: "https://www.mywebsite.com",
 				},
 			},
 			false,
@@ -335,11 +336,22 @@ func TestProfilesService_Create(t *testing.T) {
 				_, _ = w.Write([]byte(testdata.GetProfileResponse))
 			},
 		},
+		{
+			"create profile, the website must be an https url",
+			args{
+				context.Background(),
+				CreateOrUpdateProfile{Website: "http://www.mywebsite.com"},
+			},
+			true,
+			fmt.Errorf("validation_error: website must be a valid https URL"),
+			noPre,
+			errorHandler,
+		},
 		{
 			"create profile, an error is returned from the server",
 			args{
 				context.Background(),
-				CreateOrUpdateProfile{},
+				CreateOrUpdateProfile{Website: "https://www.mywebsite.com"},
 			},
 			true,
 			fmt.Errorf("500 Internal Server Error: An internal server error occurred while processing your request."),
@@ -350,10 +362,10 @@ func TestProfilesService_Create(t *testing.T) {
 			"create profile, an error occurs when parsing json",
 			args{
 				context.Background(),
-				CreateOrUpdateProfile{},
+				CreateOrUpdateProfile{Website: "https://www.mywebsite.com"},
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding Profile: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -361,7 +373,7 @@ func TestProfilesService_Create(t *testing.T) {
 			"create profile, invalid url when building request",
 			args{
 				context.Background(),
-				CreateOrUpdateProfile{},
+				CreateOrUpdateProfile{Website: "https://www.mywebsite.com"},
 			},
 			true,
 			errBadBaseURL,
@@ -453,7 +465,7 @@ func TestProfilesService_Update(t *testing.T) {
 				CreateOrUpdateProfile{},
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding Profile: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -634,7 +646,7 @@ func TestProfilesService_EnablePaymentMethod(t *testing.T) {
 				PayPal,
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding PaymentMethodDetails: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -714,6 +726,21 @@ func TestProfilesService_DisablePaymentMethod(t *testing.T) {
 				w.WriteHeader(http.StatusOK)
 			},
 		},
+		{
+			"disable payment method for profile handles no content responses.",
+			args{
+				context.Background(),
+				"pfl_v9hTwCvYqw",
+				PayPal,
+			},
+			false,
+			nil,
+			noPre,
+			func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "DELETE")
+				w.WriteHeader(http.StatusNoContent)
+			},
+		},
 		{
 			"disable payment method for profile, an error is returned from the server",
 			args{
@@ -820,7 +847,7 @@ func TestProfilesService_EnableGiftCardIssuer(t *testing.T) {
 				Good4fun,
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding GiftCardEnabled: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -900,6 +927,21 @@ func TestProfilesService_DisableGiftCardIssuer(t *testing.T) {
 				w.WriteHeader(http.StatusOK)
 			},
 		},
+		{
+			"disable giftcard issuer for profile handles no content responses.",
+			args{
+				context.Background(),
+				"pfl_v9hTwCvYqw",
+				Good4fun,
+			},
+			false,
+			nil,
+			noPre,
+			func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "DELETE")
+				w.WriteHeader(http.StatusNoContent)
+			},
+		},
 		{
 			"disable giftcard issuer for profile, an error is returned from the server",
 			args{
@@ -1002,7 +1044,7 @@ func TestProfilesService_EnableGiftCardIssuerForCurrent(t *testing.T) {
 				Good4fun,
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding GiftCardEnabled: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -1179,7 +1221,7 @@ func TestProfilesService_EnableVoucherIssuer(t *testing.T) {
 				nil,
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding VoucherIssuerEnabled: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			setAccessToken,
 			encodingHandler,
 		},
@@ -1371,7 +1413,7 @@ func TestProfileService_EnableVoucherIssuerForCurrent(t *testing.T) {
 				PluxeeEcoVoucher,
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding VoucherIssuerEnabled: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
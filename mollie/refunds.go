@@ -2,28 +2,102 @@ package mollie
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"time"
 )
 
 // CreatePaymentRefund describes the payload to create a refund associated to a payment.
 type CreatePaymentRefund struct {
-	Description string  `json:"description,omitempty"`
-	Metadata    any     `json:"metadata,omitempty"`
-	Amount      *Amount `json:"amount,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Metadata    Metadata `json:"metadata,omitempty"`
+	Amount      *Amount  `json:"amount,omitempty"`
 	PaymentRefundAccessTokenFields
 	PaymentRefundMollieConnectFields
 }
 
 // CreateOrderRefund describes the payload to create a refund associated to an order.
+//
+// Refunds without lines refund all the eligible lines of the order, otherwise
+// only the given lines are refunded.
 type CreateOrderRefund struct {
 	Description string             `json:"description,omitempty"`
-	Metadata    any                `json:"metadata,omitempty"`
-	Lines       []*OrderRefundLine `json:"lines,omitempty"`
+	Metadata    Metadata           `json:"metadata,omitempty"`
+	Lines       []*OrderRefundLine `json:"lines"`
 	PaymentRefundAccessTokenFields
 }
 
+// Validate checks every line is identified, refunded at most once and
+// has no negative quantity.
+//
+// Line amounts must be well formed and positive, they are only accepted along
+// the refunded quantity and all of them must use the same currency, as the
+// order is expressed in a single currency.
+func (r *CreateOrderRefund) Validate() error {
+	seen := make(map[string]bool, len(r.Lines))
+
+	var currency *Amount
+
+	for i, l := range r.Lines {
+		field := fmt.Sprintf("lines[%d]", i)
+
+		switch {
+		case l == nil:
+			return &ValidationError{Field: field, Reason: "is required"}
+		case l.ID == "":
+			return &ValidationError{Field: field + ".id", Reason: "is required"}
+		case seen[l.ID]:
+			return &ValidationError{Field: field + ".id", Reason: fmt.Sprintf("%s is refunded more than once", l.ID)}
+		case l.Quantity < 0:
+			return &ValidationError{Field: field + ".quantity", Reason: "must not be negative"}
+		}
+
+		if err := validateOrderRefundLineAmount(field+".amount", l, currency); err != nil {
+			return err
+		}
+
+		if currency == nil {
+			currency = l.Amount
+		}
+
+		seen[l.ID] = true
+	}
+
+	return nil
+}
+
+// validateOrderRefundLineAmount checks the amount of the line, when present,
+// is positive, comes with the refunded quantity and shares the currency of
+// the amounts of the previous lines.
+func validateOrderRefundLineAmount(field string, l *OrderRefundLine, previous *Amount) error {
+	if l.Amount == nil {
+		return nil
+	}
+
+	if err := validateAmount(field, l.Amount); err != nil {
+		return err
+	}
+
+	if r, _ := l.Amount.Rat(); r.Sign() <= 0 {
+		return &ValidationError{Field: field, Reason: "must be positive"}
+	}
+
+	if l.Quantity == 0 {
+		return &ValidationError{
+			Field:  field,
+			Reason: "requires the refunded quantity, whole lines are refunded without amount",
+		}
+	}
+
+	if previous != nil && !previous.SameCurrency(l.Amount) {
+		return &ValidationError{
+			Field:  field,
+			Reason: fmt.Sprintf("currency %s differs from %s used by the other lines", l.Amount.Currency, previous.Currency),
+		}
+	}
+
+	return nil
+}
+
 // OrderRefundLine describes the payload to create a refund associated to an order line.
 type OrderRefundLine struct {
 	Quantity int     `json:"quantity,omitempty"`
@@ -66,7 +140,7 @@ type Refund struct {
 	SettlementAmount *Amount      `json:"settlementAmount,omitempty"`
 	CreatedAt        *time.Time   `json:"createdAt,omitempty"`
 	Lines            []*OrderLine `json:"lines,omitempty"`
-	Metadata         any          `json:"metadata,omitempty"`
+	Metadata         Metadata     `json:"metadata,omitempty"`
 	Status           RefundStatus `json:"status,omitempty"`
 	Links            RefundLinks  `json:"_links,omitempty"`
 	PaymentRefundAccessTokenFields
@@ -87,13 +161,24 @@ type RefundStatus string
 
 // Valid refund status.
 const (
-	Queued     RefundStatus = "queued"
-	Pending    RefundStatus = "pending"
-	Processing RefundStatus = "processing"
-	Refunded   RefundStatus = "refunded"
-	Failed     RefundStatus = "failed"
+	Queued         RefundStatus = "queued"
+	Pending        RefundStatus = "pending"
+	Processing     RefundStatus = "processing"
+	Refunded       RefundStatus = "refunded"
+	Failed         RefundStatus = "failed"
+	RefundCanceled RefundStatus = "canceled"
 )
 
+// IsFinal reports whether the refund reached a status that will not change anymore.
+func (rs RefundStatus) IsFinal() bool {
+	switch rs {
+	case Refunded, Failed, RefundCanceled:
+		return true
+	default:
+		return false
+	}
+}
+
 // RefundLinks describes all the possible links to be returned with
 // a Refund object.
 type RefundLinks struct {
@@ -102,19 +187,45 @@ type RefundLinks struct {
 	Settlement    *URL `json:"settlement,omitempty"`
 	Order         *URL `json:"order,omitempty"`
 	Documentation *URL `json:"documentation,omitempty"`
+	extra         *Links
+}
+
+// UnmarshalJSON decodes the links, the ones not described by RefundLinks
+// remain available through Link.
+func (l *RefundLinks) UnmarshalJSON(data []byte) (err error) {
+	l.extra, err = unmarshalLinks(data, l)
+
+	return err
+}
+
+// Link returns the link with the given name, e.g. "payment".
+func (l *RefundLinks) Link(name string) *URL {
+	return lookupLink(l, l.extra, name)
+}
+
+// Link returns the link of the refund with the given name, e.g. "payment".
+func (r *Refund) Link(name string) *URL {
+	if r == nil {
+		return nil
+	}
+
+	return r.Links.Link(name)
 }
 
 // PaymentRefundOptions describes payment refund endpoint valid query string parameters.
 type PaymentRefundOptions struct {
-	Embed []EmbedValue `url:"embed,omitempty"`
+	Embed    []EmbedValue `url:"embed,omitempty,comma"`
+	Testmode bool         `url:"testmode,omitempty"`
 }
 
 // ListRefundsOptions describes payment and order refunds list endpoint valid query string parameters.
 type ListRefundsOptions struct {
-	Limit     int          `url:"limit,omitempty"`
-	From      string       `url:"from,omitempty"`
-	ProfileID string       `url:"profileId,omitempty"`
-	Embed     []EmbedValue `url:"embed,omitempty"`
+	Limit     int           `url:"limit,omitempty"`
+	From      string        `url:"from,omitempty"`
+	Sort      SortDirection `url:"sort,omitempty"`
+	ProfileID string        `url:"profileId,omitempty"`
+	Embed     []EmbedValue  `url:"embed,omitempty,comma"`
+	Testmode  bool          `url:"testmode,omitempty"`
 }
 
 // RefundsService instance operates over refund resources.
@@ -133,7 +244,7 @@ func (rs *RefundsService) List(ctx context.Context, opts *ListRefundsOptions) (
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &rl); err != nil {
+	if err = res.decode(&rl); err != nil {
 		return
 	}
 
@@ -159,7 +270,7 @@ func (rs *RefundsService) GetPaymentRefund(
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &refund); err != nil {
+	if err = res.decode(&refund); err != nil {
 		return
 	}
 
@@ -185,7 +296,7 @@ func (rs *RefundsService) ListPaymentRefunds(
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &rl); err != nil {
+	if err = res.decode(&rl); err != nil {
 		return
 	}
 
@@ -200,6 +311,7 @@ func (rs *RefundsService) CreatePaymentRefund(
 	paymentID string,
 	re CreatePaymentRefund,
 	options *PaymentRefundOptions,
+	reqOpts ...RequestOption,
 ) (
 	res *Response,
 	rf *Refund,
@@ -211,12 +323,12 @@ func (rs *RefundsService) CreatePaymentRefund(
 		re.Testmode = true
 	}
 
-	res, err = rs.client.post(ctx, uri, re, options)
+	res, err = rs.client.post(ctx, uri, re, options, reqOpts...)
 	if err != nil {
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &rf); err != nil {
+	if err = res.decode(&rf); err != nil {
 		return
 	}
 
@@ -225,47 +337,61 @@ func (rs *RefundsService) CreatePaymentRefund(
 
 // CancelPaymentRefund cancels a refund for a specific payment.
 //
+// Refunds can only be canceled while their status is queued or pending,
+// otherwise Mollie rejects the request and the API error is returned.
+//
 // See https://docs.mollie.com/reference/v2/refunds-api/cancel-payment-refund
 func (rs *RefundsService) CancelPaymentRefund(
 	ctx context.Context, paymentID, refundID string,
+	reqOpts ...RequestOption,
 ) (res *Response, err error) {
-	return rs.client.delete(ctx, fmt.Sprintf("v2/payments/%s/refunds/%s", paymentID, refundID))
+	return rs.client.delete(ctx, fmt.Sprintf("v2/payments/%s/refunds/%s", paymentID, refundID), reqOpts...)
 }
 
-// CreateOrderRefund creates a refund for a specific order.
+// CreateForOrder creates a refund for a specific order, the lines are
+// validated before sending the request.
 //
 // See https://docs.mollie.com/reference/v2/refunds-api/create-order-refund
-func (rs *RefundsService) CreateOrderRefund(
+func (rs *RefundsService) CreateForOrder(
 	ctx context.Context,
 	orderID string,
 	r CreateOrderRefund,
+	reqOpts ...RequestOption,
 ) (
 	res *Response,
 	rf *Refund,
 	err error,
 ) {
+	if err = r.Validate(); err != nil {
+		return
+	}
+
+	if r.Lines == nil {
+		r.Lines = []*OrderRefundLine{}
+	}
+
 	uri := fmt.Sprintf("v2/orders/%s/refunds", orderID)
 
 	if rs.client.HasAccessToken() && rs.client.config.testing {
 		r.Testmode = true
 	}
 
-	res, err = rs.client.post(ctx, uri, r, nil)
+	res, err = rs.client.post(ctx, uri, r, nil, reqOpts...)
 	if err != nil {
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &rf); err != nil {
+	if err = res.decode(&rf); err != nil {
 		return
 	}
 
 	return
 }
 
-// ListOrderRefunds retrieves all refunds for a specific order.
+// ListForOrder retrieves all refunds for a specific order.
 //
 // See https://docs.mollie.com/reference/v2/refunds-api/list-order-refunds
-func (rs *RefundsService) ListOrderRefunds(
+func (rs *RefundsService) ListForOrder(
 	ctx context.Context,
 	orderID string,
 	opts *ListRefundsOptions,
@@ -281,9 +407,39 @@ func (rs *RefundsService) ListOrderRefunds(
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &rl); err != nil {
+	if err = res.decode(&rl); err != nil {
 		return
 	}
 
 	return
 }
+
+// CreateOrderRefund creates a refund for a specific order.
+//
+// Deprecated: use CreateForOrder instead.
+func (rs *RefundsService) CreateOrderRefund(
+	ctx context.Context,
+	orderID string,
+	r CreateOrderRefund,
+) (
+	res *Response,
+	rf *Refund,
+	err error,
+) {
+	return rs.CreateForOrder(ctx, orderID, r)
+}
+
+// ListOrderRefunds retrieves all refunds for a specific order.
+//
+// Deprecated: use ListForOrder instead.
+func (rs *RefundsService) ListOrderRefunds(
+	ctx context.Context,
+	orderID string,
+	opts *ListRefundsOptions,
+) (
+	res *Response,
+	rl *RefundsList,
+	err error,
+) {
+	return rs.ListForOrder(ctx, orderID, opts)
+}
@@ -3,11 +3,13 @@ package mollie
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"testing"
 
 	"github.com/VictorAvelar/mollie-api-go/v4/testdata"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestRefundsService_CreatePaymentRefund(t *testing.T) {
@@ -90,7 +92,7 @@ func TestRefundsService_CreatePaymentRefund(t *testing.T) {
 				&PaymentRefundOptions{},
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding Refund: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -204,7 +206,7 @@ func TestRefundsService_GetPaymentRefund(t *testing.T) {
 				&PaymentRefundOptions{},
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding Refund: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -316,7 +318,7 @@ func TestRefundsService_ListPaymentRefunds(t *testing.T) {
 				&ListRefundsOptions{},
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding RefundsList: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -534,7 +536,7 @@ func TestRefundsService_CreateOrderRefund(t *testing.T) {
 				CreateOrderRefund{},
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding Refund: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -641,7 +643,7 @@ func TestRefundsService_ListOrderRefunds(t *testing.T) {
 				&ListRefundsOptions{},
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding RefundsList: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -743,7 +745,7 @@ func TestRefundsService_List(t *testing.T) {
 				&ListRefundsOptions{},
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding RefundsList: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -782,3 +784,163 @@ func TestRefundsService_List(t *testing.T) {
 		})
 	}
 }
+
+func TestRefundStatus_IsFinal(t *testing.T) {
+	final := map[RefundStatus]bool{
+		Queued:         false,
+		Pending:        false,
+		Processing:     false,
+		Refunded:       true,
+		Failed:         true,
+		RefundCanceled: true,
+	}
+
+	for status, want := range final {
+		assert.Equal(t, want, status.IsFinal(), status)
+	}
+}
+
+func TestRefundsService_CreateForOrder(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+
+	cases := []struct {
+		name   string
+		refund CreateOrderRefund
+		body   string
+		err    string
+	}{
+		{
+			"refunds without lines refund the whole order",
+			CreateOrderRefund{Description: "Full refund"},
+			`{"description":"Full refund","lines":[]}`,
+			"",
+		},
+		{
+			"refunds with lines only refund the given lines",
+			CreateOrderRefund{Lines: []*OrderRefundLine{{ID: "odl_dgtxyl", Quantity: 1}}},
+			`{"lines":[{"id":"odl_dgtxyl","quantity":1}]}`,
+			"",
+		},
+		{
+			"lines without id are rejected",
+			CreateOrderRefund{Lines: []*OrderRefundLine{{Quantity: 1}}},
+			"",
+			"validation_error: lines[0].id is required",
+		},
+		{
+			"lines refunded twice are rejected",
+			CreateOrderRefund{Lines: []*OrderRefundLine{{ID: "odl_dgtxyl"}, {ID: "odl_dgtxyl", Quantity: 1}}},
+			"",
+			"validation_error: lines[1].id odl_dgtxyl is refunded more than once",
+		},
+		{
+			"lines with negative quantities are rejected",
+			CreateOrderRefund{Lines: []*OrderRefundLine{{ID: "odl_dgtxyl", Quantity: -1}}},
+			"",
+			"validation_error: lines[0].quantity must not be negative",
+		},
+		{
+			"nil lines are rejected",
+			CreateOrderRefund{Lines: []*OrderRefundLine{nil}},
+			"",
+			"validation_error: lines[0] is required",
+		},
+		{
+			"lines with amounts refund part of the line",
+			CreateOrderRefund{Lines: []*OrderRefundLine{
+				{ID: "odl_dgtxyl", Quantity: 1, Amount: &Amount{Currency: "EUR", Value: "5.00"}},
+				{ID: "odl_jp31jz", Quantity: 2, Amount: &Amount{Currency: "EUR", Value: "10.00"}},
+			}},
+			`{"lines":[
+				{"id":"odl_dgtxyl","quantity":1,"amount":{"currency":"EUR","value":"5.00"}},
+				{"id":"odl_jp31jz","quantity":2,"amount":{"currency":"EUR","value":"10.00"}}
+			]}`,
+			"",
+		},
+		{
+			"malformed line amounts are rejected",
+			CreateOrderRefund{Lines: []*OrderRefundLine{
+				{ID: "odl_dgtxyl", Quantity: 1, Amount: &Amount{Currency: "EUR", Value: "5.5"}},
+			}},
+			"",
+			"validation_error: lines[0].amount must be formatted as 5.50 for EUR",
+		},
+		{
+			"zero line amounts are rejected",
+			CreateOrderRefund{Lines: []*OrderRefundLine{
+				{ID: "odl_dgtxyl", Quantity: 1, Amount: &Amount{Currency: "EUR", Value: "0.00"}},
+			}},
+			"",
+			"validation_error: lines[0].amount must be positive",
+		},
+		{
+			"line amounts without quantity are rejected",
+			CreateOrderRefund{Lines: []*OrderRefundLine{
+				{ID: "odl_dgtxyl", Amount: &Amount{Currency: "EUR", Value: "5.00"}},
+			}},
+			"",
+			"validation_error: lines[0].amount requires the refunded quantity, whole lines are refunded without amount",
+		},
+		{
+			"line amounts in different currencies are rejected",
+			CreateOrderRefund{Lines: []*OrderRefundLine{
+				{ID: "odl_dgtxyl", Quantity: 1, Amount: &Amount{Currency: "EUR", Value: "5.00"}},
+				{ID: "odl_jp31jz"},
+				{ID: "odl_k3s9da", Quantity: 1, Amount: &Amount{Currency: "USD", Value: "5.00"}},
+			}},
+			"",
+			"validation_error: lines[2].amount currency USD differs from EUR used by the other lines",
+		},
+	}
+
+	for _, c := range cases {
+		setup()
+		defer teardown()
+
+		t.Run(c.name, func(t *testing.T) {
+			tMux.HandleFunc("/v2/orders/ord_8wmqcHMN4U/refunds", func(w http.ResponseWriter, r *http.Request) {
+				if c.err != "" {
+					t.Fatal("invalid refunds must not be sent")
+				}
+
+				testMethod(t, r, "POST")
+
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				assert.JSONEq(t, c.body, string(body))
+
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(testdata.CreateOrderRefundResponse))
+			})
+
+			_, rf, err := tClient.Refunds.CreateForOrder(context.Background(), "ord_8wmqcHMN4U", c.refund)
+			if c.err != "" {
+				assert.EqualError(t, err, c.err)
+				assert.Nil(t, rf)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.IsType(t, &Refund{}, rf)
+		})
+	}
+}
+
+func TestRefundsService_ListForOrder(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	tMux.HandleFunc("/v2/orders/ord_8wmqcHMN4U/refunds", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuery(t, r, "limit=10")
+		_, _ = w.Write([]byte(testdata.ListOrderRefundResponse))
+	})
+
+	_, rl, err := tClient.Refunds.ListForOrder(context.Background(), "ord_8wmqcHMN4U", &ListRefundsOptions{Limit: 10})
+	require.NoError(t, err)
+	assert.IsType(t, &RefundsList{}, rl)
+}
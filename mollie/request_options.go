@@ -0,0 +1,72 @@
+package mollie
+
+import (
+	"context"
+	"net/http"
+)
+
+// RequestOption customizes a single request built by Client.NewAPIRequest.
+//
+// The service methods creating, updating or canceling resources accept
+// request options as trailing arguments, the remaining ones are reached
+// through WithRequestOptions.
+//
+// Request options are applied after the client default headers, including
+// authentication and idempotency keys, so their values always win.
+type RequestOption func(req *http.Request)
+
+// RequestHeader returns a request option setting the header to value,
+// replacing any value set by the client.
+func RequestHeader(key, value string) RequestOption {
+	return func(req *http.Request) {
+		req.Header.Set(key, value)
+	}
+}
+
+// RequestIdempotencyKey returns a request option sending key as the
+// idempotency key of the request, replacing the generated or derived ones.
+//
+// The same rules as WithIdempotencyKey apply, keys longer than
+// MaxIdempotencyKeyLength are rejected and the key is only sent along
+// POST, PATCH and DELETE requests.
+//
+// See: https://docs.mollie.com/overview/api-idempotency
+func RequestIdempotencyKey(key string) RequestOption {
+	return RequestHeader(IdempotencyKeyHeader, key)
+}
+
+type requestOptionsCtx struct{}
+
+// WithRequestOptions returns a copy of ctx carrying request options to be
+// applied to the requests created using it, which allows customizing the
+// requests sent by the service methods without changing the client defaults.
+//
+// Options already carried by ctx are kept and applied first, the ones passed
+// to the service methods are applied last.
+func WithRequestOptions(ctx context.Context, opts ...RequestOption) context.Context {
+	prev := requestOptionsFromContext(ctx)
+
+	merged := make([]RequestOption, 0, len(prev)+len(opts))
+	merged = append(merged, prev...)
+	merged = append(merged, opts...)
+
+	return context.WithValue(ctx, requestOptionsCtx{}, merged)
+}
+
+func requestOptionsFromContext(ctx context.Context) []RequestOption {
+	opts, _ := ctx.Value(requestOptionsCtx{}).([]RequestOption)
+
+	return opts
+}
+
+// applyRequestOptions applies the options carried by the request context
+// followed by the provided ones.
+func applyRequestOptions(req *http.Request, opts []RequestOption) {
+	for _, opt := range requestOptionsFromContext(req.Context()) {
+		opt(req)
+	}
+
+	for _, opt := range opts {
+		opt(req)
+	}
+}
@@ -0,0 +1,201 @@
+package mollie
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/VictorAvelar/mollie-api-go/v4/testdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_NewAPIRequest_RequestOptions(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	ctx := WithRequestOptions(context.Background(), RequestHeader("X-Trace-Id", "from-context"))
+	ctx = WithRequestOptions(ctx, RequestHeader("X-Mollie-Beta", "context"))
+
+	req, err := tClient.NewAPIRequest(
+		ctx,
+		http.MethodGet,
+		"v2/payments",
+		nil,
+		RequestHeader("X-Trace-Id", "explicit"),
+		RequestHeader("User-Agent", "custom-agent"),
+		RequestIdempotencyKey("order_12345_get"),
+	)
+	require.Nil(t, err)
+
+	testHeader(t, req, "X-Trace-Id", "explicit")
+	testHeader(t, req, "X-Mollie-Beta", "context")
+	testHeader(t, req, "User-Agent", "custom-agent")
+	assert.Empty(t, req.Header.Get(IdempotencyKeyHeader))
+
+	other, err := tClient.NewAPIRequest(context.Background(), http.MethodGet, "v2/payments", nil)
+	require.Nil(t, err)
+	assert.Empty(t, other.Header.Get("X-Trace-Id"))
+	assert.Equal(t, tClient.userAgent, other.Header.Get("User-Agent"))
+}
+
+func TestClient_NewAPIRequest_RequestIdempotencyKey(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	cases := []struct {
+		name   string
+		method string
+		key    string
+		want   string
+		err    error
+	}{
+		{"keys are sent along post requests", http.MethodPost, "order_12345_post", "order_12345_post", nil},
+		{"keys are sent along delete requests", http.MethodDelete, "order_12345_delete", "order_12345_delete", nil},
+		{"keys are dropped from get requests", http.MethodGet, "order_12345_get", "", nil},
+		{
+			"long keys are rejected",
+			http.MethodPost,
+			strings.Repeat("k", MaxIdempotencyKeyLength+1),
+			"",
+			errLongIdemKey,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			req, err := tClient.NewAPIRequest(
+				context.Background(),
+				c.method,
+				"v2/payments",
+				nil,
+				RequestIdempotencyKey(c.key),
+			)
+			if c.err != nil {
+				assert.ErrorIs(t, err, c.err)
+
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, c.want, req.Header.Get(IdempotencyKeyHeader))
+		})
+	}
+}
+
+func TestClient_RequestOptions_ServiceMethods(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	tMux.HandleFunc("/v2/payments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, IdempotencyKeyHeader, "order_12345_payment")
+		testHeader(t, r, "X-Trace-Id", "abc")
+		_, _ = w.Write([]byte(testdata.GetPaymentResponse))
+	})
+
+	ctx := WithRequestOptions(
+		context.Background(),
+		RequestIdempotencyKey("order_12345_payment"),
+		RequestHeader("X-Trace-Id", "abc"),
+	)
+
+	_, p, err := tClient.Payments.Create(ctx, CreatePayment{}, nil)
+	require.Nil(t, err)
+	assert.Equal(t, "tr_WDqYK6vllg", p.ID)
+}
+
+func TestClient_RequestOptions_Arguments(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+
+	cases := []struct {
+		name   string
+		method string
+		path   string
+		body   string
+		call   func(ctx context.Context, opts ...RequestOption) error
+	}{
+		{
+			"payments create",
+			http.MethodPost,
+			"/v2/payments",
+			testdata.GetPaymentResponse,
+			func(ctx context.Context, opts ...RequestOption) error {
+				_, _, err := tClient.Payments.Create(ctx, CreatePayment{}, nil, opts...)
+
+				return err
+			},
+		},
+		{
+			"payments update",
+			http.MethodPatch,
+			"/v2/payments/tr_WDqYK6vllg",
+			testdata.GetPaymentResponse,
+			func(ctx context.Context, opts ...RequestOption) error {
+				_, _, err := tClient.Payments.Update(ctx, "tr_WDqYK6vllg", UpdatePayment{}, opts...)
+
+				return err
+			},
+		},
+		{
+			"payments cancel",
+			http.MethodDelete,
+			"/v2/payments/tr_WDqYK6vllg",
+			testdata.GetPaymentResponse,
+			func(ctx context.Context, opts ...RequestOption) error {
+				_, _, err := tClient.Payments.Cancel(ctx, "tr_WDqYK6vllg", opts...)
+
+				return err
+			},
+		},
+		{
+			"refunds cancel",
+			http.MethodDelete,
+			"/v2/payments/tr_WDqYK6vllg/refunds/re_4qqhO89gsT",
+			"",
+			func(ctx context.Context, opts ...RequestOption) error {
+				_, err := tClient.Refunds.CancelPaymentRefund(ctx, "tr_WDqYK6vllg", "re_4qqhO89gsT", opts...)
+
+				return err
+			},
+		},
+	}
+
+	for _, c := range cases {
+		setup()
+		defer teardown()
+
+		t.Run(c.name, func(t *testing.T) {
+			tMux.HandleFunc(c.path, func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, c.method)
+				testHeader(t, r, IdempotencyKeyHeader, "order_12345_retry")
+				testHeader(t, r, "X-Trace-Id", "abc")
+
+				if c.body == "" {
+					w.WriteHeader(http.StatusNoContent)
+
+					return
+				}
+
+				_, _ = w.Write([]byte(c.body))
+			})
+
+			ctx := WithRequestOptions(
+				context.Background(),
+				RequestIdempotencyKey("order_12345_payment"),
+				RequestHeader("X-Trace-Id", "abc"),
+			)
+
+			err := c.call(ctx, RequestIdempotencyKey("order_12345_retry"))
+			require.Nil(t, err)
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package mollie
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Default values used by the retry policy when no custom values are provided.
+const (
+	DefaultMaxRetries   int           = 3
+	DefaultRetryBackoff time.Duration = 500 * time.Millisecond
+	maxRetryBackoff     time.Duration = 30 * time.Second
+	// maintenanceRetryBackoff is the minimum base backoff of maintenance responses.
+	maintenanceRetryBackoff time.Duration = 5 * time.Second
+)
+
+// retryPolicy describes how many times and how often a failed request is retried.
+type retryPolicy struct {
+	maxRetries int
+	base       time.Duration
+}
+
+// WithRetryPolicy enables the automatic retry of requests that fail with
+// a 429 Too Many Requests or a 5xx status code other than 501 Not Implemented.
+//
+// Retries are delayed using an exponential backoff with jitter starting at base,
+// when Mollie includes a Retry-After header in the response its value is honored instead.
+// Responses asking to wait longer than 30 seconds are not retried, the error is returned
+// right away and its RetryAfter field tells when the request can be sent again.
+// Maintenance responses, see ErrServiceUnavailable, back off from at least 5 seconds.
+// If maxRetries or base are not positive, DefaultMaxRetries and DefaultRetryBackoff are used.
+//
+// Cancelling the request context aborts any pending retry. POST requests are only
+// retried when they carry an idempotency key, this prevents duplicated side effects.
+func (c *Client) WithRetryPolicy(maxRetries int, base time.Duration) {
+	if maxRetries <= 0 {
+		maxRetries = DefaultMaxRetries
+	}
+
+	if base <= 0 {
+		base = DefaultRetryBackoff
+	}
+
+	c.retry = &retryPolicy{
+		maxRetries: maxRetries,
+		base:       base,
+	}
+}
+
+// shouldRetry reports if the request can be sent again based on the
+// received response and the number of attempts already performed.
+func (rp *retryPolicy) shouldRetry(req *http.Request, res *Response, attempt int) bool {
+	if rp == nil || attempt >= rp.maxRetries || res == nil || res.Response == nil {
+		return false
+	}
+
+	if req.Context().Err() != nil {
+		return false
+	}
+
+	if req.Method == http.MethodPost && req.Header.Get(IdempotencyKeyHeader) == "" {
+		return false
+	}
+
+	if d, ok := retryAfter(res.Header.Get("Retry-After")); ok && d > maxRetryBackoff {
+		return false
+	}
+
+	return retryableStatus(res.StatusCode)
+}
+
+// backoff returns the time to wait before performing the next attempt, it
+// never exceeds maxRetryBackoff.
+func (rp *retryPolicy) backoff(attempt int, res *Response) time.Duration {
+	if d, ok := retryAfter(res.Header.Get("Retry-After")); ok {
+		if d > maxRetryBackoff {
+			d = maxRetryBackoff
+		}
+
+		return d
+	}
+
+	base := rp.base
+	if be, ok := newError(res).(*BaseError); ok && be.maintenance() && base < maintenanceRetryBackoff {
+		base = maintenanceRetryBackoff
+	}
+
+	d := base << attempt
+	if d <= 0 || d > maxRetryBackoff {
+		d = maxRetryBackoff
+	}
+
+	//nolint: gosec
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// retryAfter parses the value of a Retry-After header, which can either
+// be a number of seconds or an HTTP date.
+func retryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+
+	if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(v); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+
+		return d, true
+	}
+
+	return 0, false
+}
+
+// sleep waits for the given duration or until the context is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
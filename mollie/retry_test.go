@@ -0,0 +1,250 @@
+package mollie
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/VictorAvelar/mollie-api-go/v4/testdata"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_WithRetryPolicy(t *testing.T) {
+	cases := []struct {
+		name      string
+		method    string
+		status    int
+		failures  int
+		noIdemKey bool
+		wantCalls int
+		wantErr   bool
+	}{
+		{
+			"get requests are retried until they succeed",
+			http.MethodGet,
+			http.StatusServiceUnavailable,
+			2,
+			false,
+			3,
+			false,
+		},
+		{
+			"rate limited requests are retried",
+			http.MethodGet,
+			http.StatusTooManyRequests,
+			1,
+			false,
+			2,
+			false,
+		},
+		{
+			"retries stop once the policy is exhausted",
+			http.MethodGet,
+			http.StatusInternalServerError,
+			10,
+			false,
+			4,
+			true,
+		},
+		{
+			"not implemented requests are not retried",
+			http.MethodGet,
+			http.StatusNotImplemented,
+			1,
+			false,
+			1,
+			true,
+		},
+		{
+			"client errors are not retried",
+			http.MethodGet,
+			http.StatusNotFound,
+			1,
+			false,
+			1,
+			true,
+		},
+		{
+			"post requests with idempotency keys are retried",
+			http.MethodPost,
+			http.StatusBadGateway,
+			1,
+			false,
+			2,
+			false,
+		},
+		{
+			"post requests without idempotency keys are not retried",
+			http.MethodPost,
+			http.StatusBadGateway,
+			1,
+			true,
+			1,
+			true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			setEnv()
+			setup()
+			defer teardown()
+			defer unsetEnv()
+
+			calls := 0
+			tMux.HandleFunc("/v2/test", func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				testMethod(t, r, c.method)
+				if calls <= c.failures {
+					w.WriteHeader(c.status)
+					_, _ = w.Write([]byte(testdata.InternalServerErrorResponse))
+
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			})
+
+			if c.noIdemKey {
+				tClient.SetIdempotencyKeyGenerator(nil)
+			}
+
+			tClient.WithRetryPolicy(3, time.Millisecond)
+
+			req, _ := tClient.NewAPIRequest(context.Background(), c.method, "v2/test", map[string]string{"a": "b"})
+			_, err := tClient.Do(req)
+			if c.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+			assert.Equal(t, c.wantCalls, calls)
+		})
+	}
+}
+
+func TestClient_WithRetryPolicy_ContextCancellation(t *testing.T) {
+	setEnv()
+	setup()
+	defer teardown()
+	defer unsetEnv()
+
+	tMux.HandleFunc("/v2/test", errorHandler)
+	tClient.WithRetryPolicy(3, time.Hour)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	req, _ := tClient.NewAPIRequest(ctx, http.MethodGet, "v2/test", nil)
+
+	start := time.Now()
+	_, err := tClient.Do(req)
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestClient_WithRetryPolicy_Defaults(t *testing.T) {
+	c := &Client{}
+	c.WithRetryPolicy(0, 0)
+
+	assert.Equal(t, DefaultMaxRetries, c.retry.maxRetries)
+	assert.Equal(t, DefaultRetryBackoff, c.retry.base)
+}
+
+func TestRetryAfter(t *testing.T) {
+	cases := []struct {
+		name   string
+		value  string
+		want   time.Duration
+		wantOk bool
+	}{
+		{
+			"empty header",
+			"",
+			0,
+			false,
+		},
+		{
+			"seconds value",
+			"7",
+			7 * time.Second,
+			true,
+		},
+		{
+			"http date in the past",
+			"Wed, 21 Oct 2015 07:28:00 GMT",
+			0,
+			true,
+		},
+		{
+			"invalid value",
+			"soon",
+			0,
+			false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, ok := retryAfter(c.value)
+			assert.Equal(t, c.wantOk, ok)
+			assert.Equal(t, c.want, got)
+		})
+	}
+}
+
+func TestRetryPolicy_backoff_Maintenance(t *testing.T) {
+	rp := &retryPolicy{maxRetries: 3, base: time.Millisecond}
+
+	response := func(status int, body string) *Response {
+		return &Response{
+			Response: &http.Response{StatusCode: status, Header: http.Header{}},
+			content:  []byte(body),
+		}
+	}
+
+	maintenance := response(http.StatusServiceUnavailable, testdata.ServiceUnavailableErrorResponse)
+	transient := response(http.StatusServiceUnavailable, testdata.TransientServiceUnavailableErrorResponse)
+	statusPage := response(http.StatusServiceUnavailable, testdata.StatusPageServiceUnavailableErrorResponse)
+
+	assert.LessOrEqual(t, rp.backoff(0, transient), time.Millisecond)
+	assert.LessOrEqual(t, rp.backoff(0, statusPage), time.Millisecond)
+	assert.GreaterOrEqual(t, rp.backoff(0, maintenance), maintenanceRetryBackoff/2)
+	assert.LessOrEqual(t, rp.backoff(0, maintenance), maintenanceRetryBackoff)
+	assert.GreaterOrEqual(t, rp.backoff(1, maintenance), maintenanceRetryBackoff)
+
+	maintenance.Header.Set("Retry-After", "1")
+	assert.Equal(t, time.Second, rp.backoff(0, maintenance))
+
+	maintenance.Header.Set("Retry-After", "3600")
+	assert.Equal(t, maxRetryBackoff, rp.backoff(0, maintenance))
+}
+
+func TestClient_WithRetryPolicy_LongRetryAfter(t *testing.T) {
+	setEnv()
+	setup()
+	defer teardown()
+	defer unsetEnv()
+
+	calls := 0
+	tMux.HandleFunc("/v2/test", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte(testdata.ServiceUnavailableErrorResponse))
+	})
+
+	tClient.WithRetryPolicy(3, time.Millisecond)
+
+	req, _ := tClient.NewAPIRequest(context.Background(), http.MethodGet, "v2/test", nil)
+
+	start := time.Now()
+	_, err := tClient.Do(req)
+
+	var apiErr *BaseError
+	assert.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, time.Hour, apiErr.RetryAfter)
+	assert.Equal(t, 1, calls)
+	assert.Less(t, time.Since(start), time.Second)
+}
@@ -2,9 +2,11 @@ package mollie
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"sync"
 	"time"
+
+	"github.com/VictorAvelar/mollie-api-go/v4/pkg/pagination"
 )
 
 // SettlementStatus describes the status of the settlement.
@@ -81,14 +83,14 @@ type Settlement struct {
 type ListSettlementsOptions struct {
 	From  string       `url:"from,omitempty"`
 	Limit int          `url:"limit,omitempty"`
-	Embed []EmbedValue `url:"embed,omitempty"`
+	Embed []EmbedValue `url:"embed,omitempty,comma"`
 }
 
 // SettlementsList describes a list of settlements.
 type SettlementsList struct {
 	Count    int `json:"count,omitempty"`
 	Embedded struct {
-		Settlements []*Settlement
+		Settlements []*Settlement `json:"settlements,omitempty"`
 	} `json:"_embedded,omitempty"`
 	Links PaginationLinks `json:"_links,omitempty"`
 }
@@ -131,7 +133,7 @@ func (ss *SettlementsService) List(ctx context.Context, slo *ListSettlementsOpti
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &sl); err != nil {
+	if err = res.decode(&sl); err != nil {
 		return
 	}
 
@@ -152,17 +154,17 @@ func (ss *SettlementsService) ListPayments(ctx context.Context, settlement strin
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &pl); err != nil {
+	if err = res.decode(&pl); err != nil {
 		return
 	}
 
 	return
 }
 
-// GetRefunds retrieves all refunds included in a settlement.
+// ListRefunds retrieves all refunds included in a settlement.
 //
 // See: https://docs.mollie.com/reference/v2/settlements-api/list-settlement-refunds
-func (ss *SettlementsService) GetRefunds(ctx context.Context, settlement string, slo *ListSettlementsOptions) (
+func (ss *SettlementsService) ListRefunds(ctx context.Context, settlement string, slo *ListSettlementsOptions) (
 	res *Response,
 	rl *RefundsList,
 	err error,
@@ -172,17 +174,17 @@ func (ss *SettlementsService) GetRefunds(ctx context.Context, settlement string,
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &rl); err != nil {
+	if err = res.decode(&rl); err != nil {
 		return
 	}
 
 	return
 }
 
-// GetChargebacks retrieves all chargebacks included in a settlement.
+// ListChargebacks retrieves all chargebacks included in a settlement.
 //
 // See: https://docs.mollie.com/reference/v2/settlements-api/list-settlement-chargebacks
-func (ss *SettlementsService) GetChargebacks(ctx context.Context, settlement string, slo *ListChargebacksOptions) (
+func (ss *SettlementsService) ListChargebacks(ctx context.Context, settlement string, slo *ListChargebacksOptions) (
 	res *Response,
 	cl *ChargebacksList,
 	err error,
@@ -192,17 +194,17 @@ func (ss *SettlementsService) GetChargebacks(ctx context.Context, settlement str
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &cl); err != nil {
+	if err = res.decode(&cl); err != nil {
 		return
 	}
 
 	return
 }
 
-// GetCaptures retrieves all captures included in a settlement.
+// ListCaptures retrieves all captures included in a settlement.
 //
 // See: https://docs.mollie.com/reference/v2/settlements-api/list-settlement-captures
-func (ss *SettlementsService) GetCaptures(ctx context.Context, settlement string, slo *ListSettlementsOptions) (
+func (ss *SettlementsService) ListCaptures(ctx context.Context, settlement string, slo *ListSettlementsOptions) (
 	res *Response,
 	cl *CapturesList,
 	err error,
@@ -212,20 +214,244 @@ func (ss *SettlementsService) GetCaptures(ctx context.Context, settlement string
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &cl); err != nil {
+	if err = res.decode(&cl); err != nil {
 		return
 	}
 
 	return
 }
 
+// GetRefunds retrieves all refunds included in a settlement.
+//
+// Deprecated: use ListRefunds instead.
+func (ss *SettlementsService) GetRefunds(ctx context.Context, settlement string, slo *ListSettlementsOptions) (
+	res *Response,
+	rl *RefundsList,
+	err error,
+) {
+	return ss.ListRefunds(ctx, settlement, slo)
+}
+
+// GetChargebacks retrieves all chargebacks included in a settlement.
+//
+// Deprecated: use ListChargebacks instead.
+func (ss *SettlementsService) GetChargebacks(ctx context.Context, settlement string, slo *ListChargebacksOptions) (
+	res *Response,
+	cl *ChargebacksList,
+	err error,
+) {
+	return ss.ListChargebacks(ctx, settlement, slo)
+}
+
+// GetCaptures retrieves all captures included in a settlement.
+//
+// Deprecated: use ListCaptures instead.
+func (ss *SettlementsService) GetCaptures(ctx context.Context, settlement string, slo *ListSettlementsOptions) (
+	res *Response,
+	cl *CapturesList,
+	err error,
+) {
+	return ss.ListCaptures(ctx, settlement, slo)
+}
+
+// ReconcileTotals sums the settlement amounts of a type of settled resources.
+type ReconcileTotals struct {
+	Count  int
+	Amount *Amount
+}
+
+// ReconcileReport summarizes the payments, refunds and chargebacks included
+// in a settlement.
+//
+// The amounts are the settlement amounts of the resources, refunds and
+// chargebacks are deducted from the settlement, so their totals are negative,
+// and Net is the sum of the three totals. Net does not include the costs
+// invoiced by Mollie, see the settlement periods for them.
+type ReconcileReport struct {
+	SettlementID string
+	Payments     ReconcileTotals
+	Refunds      ReconcileTotals
+	Chargebacks  ReconcileTotals
+	Net          *Amount
+}
+
+// Reconcile retrieves every page of the payments, refunds and chargebacks
+// included in the settlement and sums their settlement amounts.
+//
+// The three resources are retrieved concurrently, the first error found
+// cancels the remaining requests and is returned.
+func (ss *SettlementsService) Reconcile(ctx context.Context, settlementID string) (ReconcileReport, error) {
+	report := ReconcileReport{SettlementID: settlementID}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	fetches := []func(context.Context) error{
+		func(ctx context.Context) (err error) {
+			report.Payments, err = ss.reconcilePayments(ctx, settlementID)
+			return
+		},
+		func(ctx context.Context) (err error) {
+			report.Refunds, err = ss.reconcileRefunds(ctx, settlementID)
+			return
+		},
+		func(ctx context.Context) (err error) {
+			report.Chargebacks, err = ss.reconcileChargebacks(ctx, settlementID)
+			return
+		},
+	}
+
+	var (
+		wg   sync.WaitGroup
+		once sync.Once
+		ferr error
+	)
+
+	for _, fetch := range fetches {
+		wg.Add(1)
+
+		go func(fetch func(context.Context) error) {
+			defer wg.Done()
+
+			if err := fetch(ctx); err != nil {
+				once.Do(func() {
+					ferr = err
+					cancel()
+				})
+			}
+		}(fetch)
+	}
+
+	wg.Wait()
+
+	if ferr != nil {
+		return report, ferr
+	}
+
+	for _, t := range []ReconcileTotals{report.Payments, report.Refunds, report.Chargebacks} {
+		net, err := addAmounts(report.Net, t.Amount)
+		if err != nil {
+			return report, err
+		}
+
+		report.Net = net
+	}
+
+	return report, nil
+}
+
+func (ss *SettlementsService) reconcilePayments(ctx context.Context, settlementID string) (ReconcileTotals, error) {
+	return reconcilePages(func(from string) ([]*Amount, PaginationLinks, error) {
+		_, pl, err := ss.ListPayments(ctx, settlementID, &ListPaymentsOptions{From: from, Limit: MaxListLimit})
+		if err != nil {
+			return nil, PaginationLinks{}, err
+		}
+
+		amounts := make([]*Amount, 0, len(pl.Embedded.Payments))
+		for _, p := range pl.Embedded.Payments {
+			amounts = append(amounts, p.SettlementAmount)
+		}
+
+		return amounts, pl.Links, nil
+	})
+}
+
+func (ss *SettlementsService) reconcileRefunds(ctx context.Context, settlementID string) (ReconcileTotals, error) {
+	return reconcilePages(func(from string) ([]*Amount, PaginationLinks, error) {
+		_, rl, err := ss.ListRefunds(ctx, settlementID, &ListSettlementsOptions{From: from, Limit: MaxListLimit})
+		if err != nil {
+			return nil, PaginationLinks{}, err
+		}
+
+		amounts := make([]*Amount, 0, len(rl.Embedded.Refunds))
+		for _, r := range rl.Embedded.Refunds {
+			amounts = append(amounts, r.SettlementAmount)
+		}
+
+		return amounts, rl.Links, nil
+	})
+}
+
+func (ss *SettlementsService) reconcileChargebacks(ctx context.Context, settlementID string) (ReconcileTotals, error) {
+	return reconcilePages(func(from string) ([]*Amount, PaginationLinks, error) {
+		_, cl, err := ss.ListChargebacks(ctx, settlementID, &ListChargebacksOptions{From: from, Limit: MaxListLimit})
+		if err != nil {
+			return nil, PaginationLinks{}, err
+		}
+
+		amounts := make([]*Amount, 0, len(cl.Embedded.Chargebacks))
+		for _, cb := range cl.Embedded.Chargebacks {
+			amounts = append(amounts, cb.SettlementAmount)
+		}
+
+		return amounts, cl.Links, nil
+	})
+}
+
+// reconcilePages sums the amounts of every page returned by page, following
+// the pagination links until the last page.
+func reconcilePages(page func(from string) ([]*Amount, PaginationLinks, error)) (ReconcileTotals, error) {
+	var (
+		t    ReconcileTotals
+		from string
+	)
+
+	for {
+		amounts, links, err := page(from)
+		if err != nil {
+			return t, err
+		}
+
+		for _, a := range amounts {
+			if t, err = t.add(a); err != nil {
+				return t, err
+			}
+		}
+
+		if from, err = nextFrom(links); err != nil || from == "" {
+			return t, err
+		}
+	}
+}
+
+// add counts a resource and sums its amount to the totals.
+func (t ReconcileTotals) add(a *Amount) (ReconcileTotals, error) {
+	sum, err := addAmounts(t.Amount, a)
+	if err != nil {
+		return t, err
+	}
+
+	return ReconcileTotals{Count: t.Count + 1, Amount: sum}, nil
+}
+
+// addAmounts sums two amounts, nil amounts are skipped.
+func addAmounts(a, b *Amount) (*Amount, error) {
+	switch {
+	case b == nil:
+		return a, nil
+	case a == nil:
+		return &Amount{Currency: b.Currency, Value: b.Value}, nil
+	default:
+		return a.Add(b)
+	}
+}
+
+// nextFrom returns the starting point of the next page, empty on the last page.
+func nextFrom(links PaginationLinks) (string, error) {
+	if links.Next == nil {
+		return "", nil
+	}
+
+	return pagination.ExtractFromQueryParam(links.Next.Href)
+}
+
 func (ss *SettlementsService) get(ctx context.Context, element string) (res *Response, s *Settlement, err error) {
 	res, err = ss.client.get(ctx, fmt.Sprintf("v2/settlements/%s", element), nil)
 	if err != nil {
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &s); err != nil {
+	if err = res.decode(&s); err != nil {
 		return
 	}
 
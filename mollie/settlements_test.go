@@ -8,6 +8,7 @@ import (
 
 	"github.com/VictorAvelar/mollie-api-go/v4/testdata"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestSettlementsService_Get(t *testing.T) {
@@ -63,7 +64,7 @@ func TestSettlementsService_Get(t *testing.T) {
 				"stl_jDk30akdN",
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding Settlement: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -154,7 +155,7 @@ func TestSettlementsService_Next(t *testing.T) {
 				"next",
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding Settlement: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -245,7 +246,7 @@ func TestSettlementsService_Open(t *testing.T) {
 				"open",
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding Settlement: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -343,7 +344,7 @@ func TestSettlementsService_List(t *testing.T) {
 				},
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding SettlementsList: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -447,7 +448,7 @@ func TestSettlementsService_GetPayments(t *testing.T) {
 				},
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding PaymentList: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -552,7 +553,7 @@ func TestSettlementsService_GetCaptures(t *testing.T) {
 				},
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding CapturesList: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -657,7 +658,7 @@ func TestSettlementsService_GetChargebacks(t *testing.T) {
 				},
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding ChargebacksList: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -762,7 +763,7 @@ func TestSettlementsService_GetRefunds(t *testing.T) {
 				},
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding RefundsList: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -802,3 +803,133 @@ func TestSettlementsService_GetRefunds(t *testing.T) {
 		})
 	}
 }
+
+func TestSettlementsService_ListSubResources(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+
+	cases := []struct {
+		name     string
+		category string
+		content  string
+		call     func() (*Response, int, error)
+	}{
+		{
+			"list settlement refunds",
+			"refunds",
+			testdata.ListRefundsResponse,
+			func() (*Response, int, error) {
+				res, l, err := tClient.Settlements.ListRefunds(context.Background(), "stl_jDk30akdN", nil)
+				if err != nil {
+					return res, 0, err
+				}
+
+				return res, len(l.Embedded.Refunds), nil
+			},
+		},
+		{
+			"list settlement chargebacks",
+			"chargebacks",
+			testdata.ListChargebacksResponse,
+			func() (*Response, int, error) {
+				res, l, err := tClient.Settlements.ListChargebacks(context.Background(), "stl_jDk30akdN", nil)
+				if err != nil {
+					return res, 0, err
+				}
+
+				return res, len(l.Embedded.Chargebacks), nil
+			},
+		},
+		{
+			"list settlement captures",
+			"captures",
+			testdata.ListCapturesResponse,
+			func() (*Response, int, error) {
+				res, l, err := tClient.Settlements.ListCaptures(context.Background(), "stl_jDk30akdN", nil)
+				if err != nil {
+					return res, 0, err
+				}
+
+				return res, len(l.Embedded.Captures), nil
+			},
+		},
+	}
+
+	for _, c := range cases {
+		setup()
+		defer teardown()
+
+		t.Run(c.name, func(t *testing.T) {
+			tMux.HandleFunc(
+				fmt.Sprintf("/v2/settlements/stl_jDk30akdN/%s", c.category),
+				func(w http.ResponseWriter, r *http.Request) {
+					testMethod(t, r, "GET")
+					_, _ = w.Write([]byte(c.content))
+				},
+			)
+
+			res, n, err := c.call()
+			assert.Nil(t, err)
+			assert.IsType(t, &http.Response{}, res.Response)
+			assert.NotZero(t, n)
+		})
+	}
+}
+
+func TestSettlementsService_Reconcile(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	tMux.HandleFunc("/v2/settlements/stl_jDk30akdN/payments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		switch r.URL.Query().Get("from") {
+		case "":
+			testQuery(t, r, "limit=250")
+			_, _ = w.Write([]byte(testdata.ListSettlementPaymentsFirstPageResponse))
+		case "tr_Hd3a5fYp2c":
+			testQuery(t, r, "from=tr_Hd3a5fYp2c&limit=250")
+			_, _ = w.Write([]byte(testdata.ListSettlementPaymentsLastPageResponse))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	tMux.HandleFunc("/v2/settlements/stl_jDk30akdN/refunds", func(w http.ResponseWriter, r *http.Request) {
+		testQuery(t, r, "limit=250")
+		_, _ = w.Write([]byte(testdata.ListSettlementRefundsResponse))
+	})
+	tMux.HandleFunc("/v2/settlements/stl_jDk30akdN/chargebacks", func(w http.ResponseWriter, r *http.Request) {
+		testQuery(t, r, "limit=250")
+		_, _ = w.Write([]byte(testdata.ListSettlementChargebacksResponse))
+	})
+
+	report, err := tClient.Settlements.Reconcile(context.Background(), "stl_jDk30akdN")
+	require.NoError(t, err)
+
+	assert.Equal(t, "stl_jDk30akdN", report.SettlementID)
+	assert.Equal(t, ReconcileTotals{Count: 3, Amount: &Amount{Currency: "EUR", Value: "40.00"}}, report.Payments)
+	assert.Equal(t, ReconcileTotals{Count: 1, Amount: &Amount{Currency: "EUR", Value: "-5.00"}}, report.Refunds)
+	assert.Equal(t, ReconcileTotals{Count: 1, Amount: &Amount{Currency: "EUR", Value: "-10.00"}}, report.Chargebacks)
+	assert.Equal(t, &Amount{Currency: "EUR", Value: "25.00"}, report.Net)
+}
+
+func TestSettlementsService_Reconcile_Errors(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	tMux.HandleFunc("/v2/settlements/stl_jDk30akdN/payments", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(testdata.ListSettlementPaymentsLastPageResponse))
+	})
+	tMux.HandleFunc("/v2/settlements/stl_jDk30akdN/refunds", errorHandler)
+	tMux.HandleFunc("/v2/settlements/stl_jDk30akdN/chargebacks", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(testdata.ListSettlementChargebacksResponse))
+	})
+
+	report, err := tClient.Settlements.Reconcile(context.Background(), "stl_jDk30akdN")
+	assert.EqualError(t, err, "500 Internal Server Error: An internal server error occurred while processing your request.")
+	assert.Nil(t, report.Net)
+}
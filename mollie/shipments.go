@@ -2,7 +2,6 @@ package mollie
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"time"
 )
@@ -14,6 +13,16 @@ type CreateShipment struct {
 	ShipmentAccessTokenFields
 }
 
+// Validate checks the tracking details when provided, omitting the lines
+// ships all the shippable lines of the order.
+func (cs *CreateShipment) Validate() error {
+	if cs.Tracking == nil {
+		return nil
+	}
+
+	return cs.Tracking.Validate()
+}
+
 // ShipmentAccessTokenFields describes the fields available when using an access token.
 type ShipmentAccessTokenFields struct {
 	Testmode bool `json:"testmode,omitempty"`
@@ -39,6 +48,20 @@ type UpdateShipment struct {
 	ShipmentAccessTokenFields
 }
 
+// Validate checks the update contains tracking details, the lines of an
+// existing shipment can not be modified so tracking is the only field
+// Mollie accepts when updating a shipment.
+func (us *UpdateShipment) Validate() error {
+	if us.Tracking == nil {
+		return &ValidationError{
+			Field:  "tracking",
+			Reason: "is required, only the tracking details of a shipment can be updated",
+		}
+	}
+
+	return us.Tracking.Validate()
+}
+
 // ShipmentTracking contains shipment tracking details.
 type ShipmentTracking struct {
 	Carrier string `json:"carrier,omitempty"`
@@ -46,6 +69,20 @@ type ShipmentTracking struct {
 	URL     string `json:"url,omitempty"`
 }
 
+// Validate checks the carrier and tracking code are present,
+// Mollie requires both as soon as tracking details are sent.
+func (st *ShipmentTracking) Validate() error {
+	if st.Carrier == "" {
+		return &ValidationError{Field: "tracking.carrier", Reason: "is required"}
+	}
+
+	if st.Code == "" {
+		return &ValidationError{Field: "tracking.code", Reason: "is required"}
+	}
+
+	return nil
+}
+
 // ShipmentLinks contains URL objects with shipment relevant
 // information for the user.
 type ShipmentLinks struct {
@@ -58,7 +95,7 @@ type ShipmentLinks struct {
 type ShipmentsList struct {
 	Count    int `json:"count,omitempty"`
 	Embedded struct {
-		Shipments []Shipment
+		Shipments []Shipment `json:"shipments,omitempty"`
 	} `json:"_embedded,omitempty"`
 	Links PaginationLinks `json:"_links,omitempty"`
 }
@@ -81,7 +118,7 @@ func (ss *ShipmentsService) Get(ctx context.Context, order string, shipment stri
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &s); err != nil {
+	if err = res.decode(&s); err != nil {
 		return
 	}
 
@@ -91,7 +128,7 @@ func (ss *ShipmentsService) Get(ctx context.Context, order string, shipment stri
 // Create can be used to ship order lines.
 //
 // See: https://docs.mollie.com/reference/v2/shipments-api/create-shipment
-func (ss *ShipmentsService) Create(ctx context.Context, order string, cs CreateShipment) (
+func (ss *ShipmentsService) Create(ctx context.Context, order string, cs CreateShipment, reqOpts ...RequestOption) (
 	res *Response,
 	s *Shipment,
 	err error,
@@ -102,12 +139,16 @@ func (ss *ShipmentsService) Create(ctx context.Context, order string, cs CreateS
 		cs.Testmode = true
 	}
 
-	res, err = ss.client.post(ctx, uri, cs, nil)
+	if err = cs.Validate(); err != nil {
+		return
+	}
+
+	res, err = ss.client.post(ctx, uri, cs, nil, reqOpts...)
 	if err != nil {
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &s); err != nil {
+	if err = res.decode(&s); err != nil {
 		return
 	}
 
@@ -125,29 +166,44 @@ func (ss *ShipmentsService) List(ctx context.Context, order string) (res *Respon
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &sl); err != nil {
+	if err = res.decode(&sl); err != nil {
 		return
 	}
 
 	return
 }
 
-// Update can be used to update the tracking information of a shipment
+// Update can be used to update the tracking information of a shipment,
+// the shipped lines can not be changed once the shipment is created.
 //
 // See: https://docs.mollie.com/reference/v2/shipments-api/update-shipment
-func (ss *ShipmentsService) Update(ctx context.Context, order string, shipment string, us UpdateShipment) (
+func (ss *ShipmentsService) Update(
+	ctx context.Context,
+	order string,
+	shipment string,
+	us UpdateShipment,
+	reqOpts ...RequestOption,
+) (
 	res *Response,
 	s *Shipment,
 	err error,
 ) {
 	u := fmt.Sprintf("v2/orders/%s/shipments/%s", order, shipment)
 
-	res, err = ss.client.patch(ctx, u, us)
+	if ss.client.HasAccessToken() && ss.client.config.testing {
+		us.Testmode = true
+	}
+
+	if err = us.Validate(); err != nil {
+		return
+	}
+
+	res, err = ss.client.patch(ctx, u, us, reqOpts...)
 	if err != nil {
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &s); err != nil {
+	if err = res.decode(&s); err != nil {
 		return
 	}
 
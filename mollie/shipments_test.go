@@ -67,7 +67,7 @@ func TestShipmentsService_Get(t *testing.T) {
 				"shp_3wmsgCJN4U",
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding Shipment: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -163,7 +163,7 @@ func TestShipmentsService_List(t *testing.T) {
 				"shp_3wmsgCJN4U",
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding ShipmentsList: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -272,6 +272,20 @@ func TestShipmentsService_Create(t *testing.T) {
 				_, _ = w.Write([]byte(testdata.GetShipmentsResponse))
 			},
 		},
+		{
+			"create shipment, tracking carrier is required when tracking is sent",
+			args{
+				context.Background(),
+				"ord_kEn1PlbGa",
+				CreateShipment{
+					Tracking: &ShipmentTracking{Code: "3wmsgCJN4U"},
+				},
+			},
+			true,
+			fmt.Errorf("validation_error: tracking.carrier is required"),
+			noPre,
+			errorHandler,
+		},
 		{
 			"create shipment, an error is returned from the server",
 			args{
@@ -292,7 +306,7 @@ func TestShipmentsService_Create(t *testing.T) {
 				CreateShipment{},
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding Shipment: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -405,7 +419,7 @@ func TestShipmentsService_Update(t *testing.T) {
 			},
 		},
 		{
-			"update shipment, an error is returned from the server",
+			"update shipment, tracking details are required",
 			args{
 				context.Background(),
 				"ord_kEn1PlbGa",
@@ -413,6 +427,36 @@ func TestShipmentsService_Update(t *testing.T) {
 				UpdateShipment{},
 			},
 			true,
+			fmt.Errorf("validation_error: tracking is required, only the tracking details of a shipment can be updated"),
+			noPre,
+			errorHandler,
+		},
+		{
+			"update shipment, tracking code is required",
+			args{
+				context.Background(),
+				"ord_kEn1PlbGa",
+				"shp_3wmsgCJN4U",
+				UpdateShipment{
+					Tracking: &ShipmentTracking{Carrier: "dhl"},
+				},
+			},
+			true,
+			fmt.Errorf("validation_error: tracking.code is required"),
+			noPre,
+			errorHandler,
+		},
+		{
+			"update shipment, an error is returned from the server",
+			args{
+				context.Background(),
+				"ord_kEn1PlbGa",
+				"shp_3wmsgCJN4U",
+				UpdateShipment{
+					Tracking: &ShipmentTracking{Carrier: "dhl", Code: "3wmsgCJN4U"},
+				},
+			},
+			true,
 			fmt.Errorf("500 Internal Server Error: An internal server error occurred while processing your request."),
 			noPre,
 			errorHandler,
@@ -423,10 +467,12 @@ func TestShipmentsService_Update(t *testing.T) {
 				context.Background(),
 				"ord_kEn1PlbGa",
 				"shp_3wmsgCJN4U",
-				UpdateShipment{},
+				UpdateShipment{
+					Tracking: &ShipmentTracking{Carrier: "dhl", Code: "3wmsgCJN4U"},
+				},
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding Shipment: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -436,7 +482,9 @@ func TestShipmentsService_Update(t *testing.T) {
 				context.Background(),
 				"ord_kEn1PlbGa",
 				"shp_3wmsgCJN4U",
-				UpdateShipment{},
+				UpdateShipment{
+					Tracking: &ShipmentTracking{Carrier: "dhl", Code: "3wmsgCJN4U"},
+				},
 			},
 			true,
 			errBadBaseURL,
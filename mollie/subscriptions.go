@@ -2,7 +2,6 @@ package mollie
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"time"
 )
@@ -29,7 +28,7 @@ type CreateSubscription struct {
 	Amount      *Amount       `json:"amount,omitempty"`
 	StartDate   *ShortDate    `json:"startDate,omitempty"`
 	Method      PaymentMethod `json:"method,omitempty"`
-	Metadata    any           `json:"metadata,omitempty"`
+	Metadata    Metadata      `json:"metadata,omitempty"`
 	SubscriptionAccessTokenFields
 }
 
@@ -41,16 +40,18 @@ type SubscriptionAccessTokenFields struct {
 }
 
 // UpdateSubscription contains the fields that are required to create a subscription.
+//
+// WebhookURL can be cleared using Null, see Nullable.
 type UpdateSubscription struct {
-	Times       int           `json:"times,omitempty"`
-	Interval    string        `json:"interval,omitempty"`
-	Description string        `json:"description,omitempty"`
-	MandateID   string        `json:"mandateId,omitempty"`
-	WebhookURL  string        `json:"webhookUrl,omitempty"`
-	Amount      *Amount       `json:"amount,omitempty"`
-	StartDate   *ShortDate    `json:"startDate,omitempty"`
-	Method      PaymentMethod `json:"method,omitempty"`
-	Metadata    any           `json:"metadata,omitempty"`
+	Times       int               `json:"times,omitempty"`
+	Interval    string            `json:"interval,omitempty"`
+	Description string            `json:"description,omitempty"`
+	MandateID   string            `json:"mandateId,omitempty"`
+	WebhookURL  *Nullable[string] `json:"webhookUrl,omitempty"`
+	Amount      *Amount           `json:"amount,omitempty"`
+	StartDate   *ShortDate        `json:"startDate,omitempty"`
+	Method      PaymentMethod     `json:"method,omitempty"`
+	Metadata    Metadata          `json:"metadata,omitempty"`
 	SubscriptionAccessTokenFields
 }
 
@@ -58,6 +59,7 @@ type UpdateSubscription struct {
 type SubscriptionLinks struct {
 	Self          *URL `json:"self,omitempty"`
 	Customer      *URL `json:"customer,omitempty"`
+	Mandate       *URL `json:"mandate,omitempty"`
 	Profile       *URL `json:"profile,omitempty"`
 	Payments      *URL `json:"payments,omitempty"`
 	Documentation *URL `json:"documentation,omitempty"`
@@ -72,6 +74,7 @@ type Subscription struct {
 	Interval        string             `json:"interval,omitempty"`
 	Description     string             `json:"description,omitempty"`
 	MandateID       string             `json:"mandateId,omitempty"`
+	CustomerID      string             `json:"customerId,omitempty"`
 	WebhookURL      string             `json:"webhookUrl,omitempty"`
 	Amount          *Amount            `json:"amount,omitempty"`
 	ApplicationFee  *ApplicationFee    `json:"applicationFee,omitempty"`
@@ -82,7 +85,7 @@ type Subscription struct {
 	Mode            Mode               `json:"mode,omitempty"`
 	Status          SubscriptionStatus `json:"status,omitempty"`
 	Method          PaymentMethod      `json:"method,omitempty"`
-	Metadata        any                `json:"metadata,omitempty"`
+	Metadata        Metadata           `json:"metadata,omitempty"`
 	Links           SubscriptionLinks  `json:"_links,omitempty"`
 }
 
@@ -121,7 +124,7 @@ func (ss *SubscriptionsService) Get(ctx context.Context, customer, subscription
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &s); err != nil {
+	if err = res.decode(&s); err != nil {
 		return
 	}
 
@@ -131,7 +134,12 @@ func (ss *SubscriptionsService) Get(ctx context.Context, customer, subscription
 // Create stores a new subscription for a given customer
 //
 // See: https://docs.mollie.com/reference/v2/subscriptions-api/create-subscription
-func (ss *SubscriptionsService) Create(ctx context.Context, customer string, sc CreateSubscription) (
+func (ss *SubscriptionsService) Create(
+	ctx context.Context,
+	customer string,
+	sc CreateSubscription,
+	reqOpts ...RequestOption,
+) (
 	res *Response,
 	s *Subscription,
 	err error,
@@ -142,12 +150,12 @@ func (ss *SubscriptionsService) Create(ctx context.Context, customer string, sc
 		sc.Testmode = true
 	}
 
-	res, err = ss.client.post(ctx, uri, sc, nil)
+	res, err = ss.client.post(ctx, uri, sc, nil, reqOpts...)
 	if err != nil {
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &s); err != nil {
+	if err = res.decode(&s); err != nil {
 		return
 	}
 
@@ -157,19 +165,24 @@ func (ss *SubscriptionsService) Create(ctx context.Context, customer string, sc
 // Update changes fields on a subscription object
 //
 // See: https://docs.mollie.com/reference/v2/subscriptions-api/update-subscription
-func (ss *SubscriptionsService) Update(ctx context.Context, customer, subscription string, sc UpdateSubscription) (
+func (ss *SubscriptionsService) Update(
+	ctx context.Context,
+	customer, subscription string,
+	sc UpdateSubscription,
+	reqOpts ...RequestOption,
+) (
 	res *Response,
 	s *Subscription,
 	err error,
 ) {
 	u := fmt.Sprintf("v2/customers/%s/subscriptions/%s", customer, subscription)
 
-	res, err = ss.client.patch(ctx, u, sc)
+	res, err = ss.client.patch(ctx, u, sc, reqOpts...)
 	if err != nil {
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &s); err != nil {
+	if err = res.decode(&s); err != nil {
 		return
 	}
 
@@ -179,19 +192,19 @@ func (ss *SubscriptionsService) Update(ctx context.Context, customer, subscripti
 // Cancel cancels a subscription.
 //
 // See: https://docs.mollie.com/reference/v2/subscriptions-api/cancel-subscription
-func (ss *SubscriptionsService) Cancel(ctx context.Context, customer, subscription string) (
+func (ss *SubscriptionsService) Cancel(ctx context.Context, customer, subscription string, reqOpts ...RequestOption) (
 	res *Response,
 	s *Subscription,
 	err error,
 ) {
 	u := fmt.Sprintf("v2/customers/%s/subscriptions/%s", customer, subscription)
 
-	res, err = ss.client.delete(ctx, u)
+	res, err = ss.client.delete(ctx, u, reqOpts...)
 	if err != nil {
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &s); err != nil {
+	if err = res.decodeOptional(&s); err != nil {
 		return
 	}
 
@@ -215,7 +228,7 @@ func (ss *SubscriptionsService) All(ctx context.Context, opts *ListSubscriptions
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &sl); err != nil {
+	if err = res.decode(&sl); err != nil {
 		return
 	}
 
@@ -237,7 +250,7 @@ func (ss *SubscriptionsService) List(ctx context.Context, customer string, opts
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &sl); err != nil {
+	if err = res.decode(&sl); err != nil {
 		return
 	}
 
@@ -263,7 +276,7 @@ func (ss *SubscriptionsService) ListPayments(
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &sl); err != nil {
+	if err = res.decode(&sl); err != nil {
 		return
 	}
 
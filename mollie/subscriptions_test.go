@@ -67,7 +67,7 @@ func TestSubscriptionsService_Get(t *testing.T) {
 				"sub_rVKGtNd6s3",
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding Subscription: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -100,6 +100,8 @@ func TestSubscriptionsService_Get(t *testing.T) {
 			} else {
 				assert.Nil(t, err)
 				assert.IsType(t, &Subscription{}, m)
+				assert.Equal(t, "cst_stTC2WHAuS", m.CustomerID)
+				assert.NotNil(t, m.Links.Mandate)
 				assert.IsType(t, &http.Response{}, res.Response)
 			}
 		})
@@ -208,7 +210,7 @@ func TestSubscriptionsService_Create(t *testing.T) {
 				},
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding Subscription: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -360,7 +362,7 @@ func TestSubscriptionsService_Update(t *testing.T) {
 				},
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding Subscription: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -484,7 +486,7 @@ func TestSubscriptionsService_Cancel(t *testing.T) {
 				"sub_rVKGtNd6s3",
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding Subscription: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -603,7 +605,7 @@ func TestSubscriptionsService_List(t *testing.T) {
 				nil,
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding SubscriptionsList: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -717,7 +719,7 @@ func TestSubscriptionsService_All(t *testing.T) {
 				nil,
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding SubscriptionsList: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -840,7 +842,7 @@ func TestSubscriptionsService_GetPayments(t *testing.T) {
 				nil,
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding PaymentList: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
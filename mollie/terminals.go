@@ -2,7 +2,6 @@ package mollie
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"time"
 )
@@ -19,28 +18,34 @@ const (
 
 // Terminal symbolizes a physical device to receive payments.
 type Terminal struct {
-	ID           string          `json:"id,omitempty"`
-	Resource     string          `json:"resource,omitempty"`
-	ProfileID    string          `json:"profileID,omitempty"`
-	Brand        string          `json:"brand,omitempty"`
-	Model        string          `json:"model,omitempty"`
-	SerialNumber string          `json:"serialNumber,omitempty"`
-	Currency     string          `json:"currency,omitempty"`
-	Description  string          `json:"description,omitempty"`
-	CreatedAt    *time.Time      `json:"createdAt,omitempty"`
-	UpdatedAt    *time.Time      `json:"updatedAt,omitempty"`
-	Status       TerminalStatus  `json:"status,omitempty"`
-	Links        PaginationLinks `json:"_links,omitempty"`
+	ID           string         `json:"id,omitempty"`
+	Resource     string         `json:"resource,omitempty"`
+	ProfileID    string         `json:"profileId,omitempty"`
+	Brand        string         `json:"brand,omitempty"`
+	Model        string         `json:"model,omitempty"`
+	SerialNumber string         `json:"serialNumber,omitempty"`
+	Currency     string         `json:"currency,omitempty"`
+	Description  string         `json:"description,omitempty"`
+	CreatedAt    *time.Time     `json:"createdAt,omitempty"`
+	UpdatedAt    *time.Time     `json:"updatedAt,omitempty"`
+	Status       TerminalStatus `json:"status,omitempty"`
+	Links        TerminalLinks  `json:"_links,omitempty"`
+}
+
+// TerminalLinks contains URL objects relevant to the terminal.
+type TerminalLinks struct {
+	Self          *URL `json:"self,omitempty"`
+	Documentation *URL `json:"documentation,omitempty"`
 }
 
 // ListTerminalsOptions holds query string parameters valid for terminals lists.
 //
 // ProfileID and TestMode are valid only when using access tokens.
 type ListTerminalsOptions struct {
-	Testmode  bool   `url:"testMode,omitempty"`
+	Testmode  bool   `url:"testmode,omitempty"`
 	Limit     int    `url:"limit,omitempty"`
 	From      string `url:"from,omitempty"`
-	ProfileID string `url:"profileID,omitempty"`
+	ProfileID string `url:"profileId,omitempty"`
 }
 
 // TerminalList describes the response for terminals list endpoints.
@@ -62,7 +67,7 @@ func (ts *TerminalsService) Get(ctx context.Context, id string) (res *Response,
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &t); err != nil {
+	if err = res.decode(&t); err != nil {
 		return
 	}
 
@@ -75,16 +80,12 @@ func (ts *TerminalsService) List(ctx context.Context, options *ListTerminalsOpti
 	tl *TerminalList,
 	err error,
 ) {
-	if ts.client.HasAccessToken() && ts.client.config.testing {
-		options.Testmode = true
-	}
-
 	res, err = ts.client.get(ctx, "v2/terminals", options)
 	if err != nil {
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &tl); err != nil {
+	if err = res.decode(&tl); err != nil {
 		return
 	}
 
@@ -67,7 +67,7 @@ func TestTerminalsService_Get(t *testing.T) {
 				"term_7MgL4wea46qkRcoTZjWEH",
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding Terminal: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			"",
 			noPre,
 			encodingHandler,
@@ -165,6 +165,37 @@ func TestTerminalsService_List(t *testing.T) {
 				_, _ = w.Write([]byte(testdata.ListTerminalsResponse))
 			},
 		},
+		{
+			"list terminals paginates with an access token and no options",
+			args{
+				context.Background(),
+				nil,
+			},
+			false,
+			nil,
+			testdata.GetTerminalResponse,
+			setAccessToken,
+			func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "GET")
+				testQuery(t, r, "testmode=true")
+				_, _ = w.Write([]byte(testdata.ListTerminalsResponse))
+			},
+		},
+		{
+			"list terminals sends the pagination and profile parameters",
+			args{
+				context.Background(),
+				&ListTerminalsOptions{From: "term_7MgL4wea46qkRcoTZjWEH", Limit: 2, ProfileID: "pfl_QkEhN94Ba"},
+			},
+			false,
+			nil,
+			testdata.GetTerminalResponse,
+			noPre,
+			func(w http.ResponseWriter, r *http.Request) {
+				testQuery(t, r, "from=term_7MgL4wea46qkRcoTZjWEH&limit=2&profileId=pfl_QkEhN94Ba")
+				_, _ = w.Write([]byte(testdata.ListTerminalsResponse))
+			},
+		},
 		{
 			"get terminals list, an error is returned from the server",
 			args{
@@ -184,7 +215,7 @@ func TestTerminalsService_List(t *testing.T) {
 				nil,
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding TerminalList: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			"",
 			noPre,
 			encodingHandler,
@@ -219,6 +250,7 @@ func TestTerminalsService_List(t *testing.T) {
 				assert.Nil(t, err)
 				assert.IsType(t, &TerminalList{}, m)
 				assert.IsType(t, &http.Response{}, res.Response)
+				assert.Equal(t, "pfl_QkEhN94Ba", m.Embedded.Terminals[0].ProfileID)
 			}
 		})
 	}
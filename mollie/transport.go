@@ -0,0 +1,41 @@
+package mollie
+
+import (
+	"net"
+	"net/http"
+	"time"
+)
+
+// DefaultHTTPClient returns the http client used by NewClient when none is
+// provided, tuned for sending many concurrent requests to api.mollie.com.
+//
+// Its transport keeps up to 32 idle connections per host, 100 overall, for
+// 90 seconds, so bursts of requests, e.g. while following the pagination of
+// large lists, reuse open connections instead of dialing new ones. HTTP/2 is
+// attempted, dialing and the TLS handshake are bounded by 30 and 10 seconds
+// and Mollie has 60 seconds to answer with the response headers. Proxies are
+// taken from the environment. The client sets no overall timeout, use
+// Client.WithTimeout or the request context instead.
+//
+// A new client is returned on every call, so tuning it does not affect
+// other clients.
+func DefaultHTTPClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+	}
+
+	return &http.Client{
+		Transport: &http.Transport{
+			Proxy:                 http.ProxyFromEnvironment,
+			DialContext:           dialer.DialContext,
+			ForceAttemptHTTP2:     true,
+			MaxIdleConns:          100,
+			MaxIdleConnsPerHost:   32,
+			IdleConnTimeout:       90 * time.Second,
+			TLSHandshakeTimeout:   10 * time.Second,
+			ResponseHeaderTimeout: 60 * time.Second,
+			ExpectContinueTimeout: time.Second,
+		},
+	}
+}
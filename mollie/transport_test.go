@@ -0,0 +1,41 @@
+package mollie
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultHTTPClient(t *testing.T) {
+	c := DefaultHTTPClient()
+
+	tr, ok := c.Transport.(*http.Transport)
+	require.True(t, ok)
+
+	assert.True(t, tr.ForceAttemptHTTP2)
+	assert.Equal(t, 32, tr.MaxIdleConnsPerHost)
+	assert.Equal(t, 100, tr.MaxIdleConns)
+	assert.Equal(t, 90*time.Second, tr.IdleConnTimeout)
+	assert.Equal(t, 10*time.Second, tr.TLSHandshakeTimeout)
+	assert.NotNil(t, tr.Proxy)
+	assert.Zero(t, c.Timeout)
+
+	assert.NotSame(t, c, DefaultHTTPClient())
+}
+
+func TestNewClient_DefaultHTTPClient(t *testing.T) {
+	client, err := NewClient(nil, NewAPIConfig(false))
+	require.Nil(t, err)
+
+	assert.NotSame(t, http.DefaultClient, client.client)
+	assert.IsType(t, &http.Transport{}, client.client.Transport)
+
+	custom := &http.Client{}
+	client, err = NewClient(custom, NewAPIConfig(false))
+	require.Nil(t, err)
+
+	assert.Same(t, custom, client.client)
+}
@@ -18,28 +18,39 @@ const (
 type WalletsService service
 
 // ApplePaymentSession contains information about an Apple pay session.
+//
+// The session is opaque to the merchant and must be passed back unmodified
+// to the Apple Pay JS API, Raw contains the session exactly as returned by Mollie.
 type ApplePaymentSession struct {
-	EpochTimestamp    int    `json:"epochTimestamp,omitempty"`
-	ExpiresAt         int    `json:"expiresAt,omitempty"`
-	MerchantSessionID string `json:"merchantSessionIdentifier,omitempty"`
-	Nonce             string `json:"nonce,omitempty"`
-	MerchantID        string `json:"merchantIdentified,omitempty"`
-	DomainName        string `json:"domainName,omitempty"`
-	DisplayName       string `json:"displayName,omitempty"`
-	Signature         string `json:"signature,omitempty"`
+	EpochTimestamp    int64           `json:"epochTimestamp,omitempty"`
+	ExpiresAt         int64           `json:"expiresAt,omitempty"`
+	MerchantSessionID string          `json:"merchantSessionIdentifier,omitempty"`
+	Nonce             string          `json:"nonce,omitempty"`
+	MerchantID        string          `json:"merchantIdentifier,omitempty"`
+	DomainName        string          `json:"domainName,omitempty"`
+	DisplayName       string          `json:"displayName,omitempty"`
+	Signature         string          `json:"signature,omitempty"`
+	Raw               json.RawMessage `json:"-"`
 }
 
+// ApplePaySession is an alias for ApplePaymentSession.
+type ApplePaySession = ApplePaymentSession
+
 // ApplePaymentSessionRequest contains the body parameters for requesting
 // a valid PaymentSession from Apple.
 type ApplePaymentSessionRequest struct {
 	Domain        string `json:"domain,omitempty"`
 	ValidationURL string `json:"validationUrl,omitempty"`
+	ProfileID     string `json:"profileId,omitempty"`
 }
 
-// ApplePaymentSession returns an Apple Payment Session object valid for one transaction.
+// RequestApplePayPaymentSession returns an Apple Payment Session object valid for one transaction.
+//
+// Errors returned by the Mollie API, like an invalid validation url, are
+// returned as a *BaseError.
 //
 // See: https://docs.mollie.com/reference/v2/wallets-api/request-apple-pay-payment-session
-func (ms *WalletsService) ApplePaymentSession(ctx context.Context, asr *ApplePaymentSessionRequest) (
+func (ms *WalletsService) RequestApplePayPaymentSession(ctx context.Context, asr *ApplePaymentSessionRequest) (
 	res *Response,
 	aps *ApplePaymentSession,
 	err error,
@@ -51,9 +62,24 @@ func (ms *WalletsService) ApplePaymentSession(ctx context.Context, asr *ApplePay
 		return
 	}
 
-	if err = json.Unmarshal(res.content, &aps); err != nil {
+	if err = res.decode(&aps); err != nil {
 		return
 	}
 
+	if aps != nil {
+		aps.Raw = json.RawMessage(res.content)
+	}
+
 	return
 }
+
+// ApplePaymentSession returns an Apple Payment Session object valid for one transaction.
+//
+// Deprecated: use RequestApplePayPaymentSession instead.
+func (ms *WalletsService) ApplePaymentSession(ctx context.Context, asr *ApplePaymentSessionRequest) (
+	res *Response,
+	aps *ApplePaymentSession,
+	err error,
+) {
+	return ms.RequestApplePayPaymentSession(ctx, asr)
+}
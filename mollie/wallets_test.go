@@ -2,12 +2,14 @@ package mollie
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
 
 	"github.com/VictorAvelar/mollie-api-go/v4/testdata"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestMiscellaneousService_ApplePaymentSession(t *testing.T) {
@@ -66,7 +68,7 @@ func TestMiscellaneousService_ApplePaymentSession(t *testing.T) {
 				nil,
 			},
 			true,
-			fmt.Errorf("invalid character 'h' looking for beginning of object key string"),
+			fmt.Errorf("mollie: decoding ApplePaymentSession: invalid character 'h' looking for beginning of object key string (body: {hello: [{},]})"),
 			noPre,
 			encodingHandler,
 		},
@@ -91,7 +93,7 @@ func TestMiscellaneousService_ApplePaymentSession(t *testing.T) {
 			c.pre()
 			tMux.HandleFunc("/v2/wallets/applepay/sessions", c.handler)
 
-			res, m, err := tClient.Wallets.ApplePaymentSession(c.args.ctx, c.args.appleSess)
+			res, m, err := tClient.Wallets.RequestApplePayPaymentSession(c.args.ctx, c.args.appleSess)
 			if c.wantErr {
 				assert.NotNil(t, err)
 				assert.EqualError(t, err, c.err.Error())
@@ -103,3 +105,53 @@ func TestMiscellaneousService_ApplePaymentSession(t *testing.T) {
 		})
 	}
 }
+
+func TestWalletsService_RequestApplePayPaymentSession(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	tMux.HandleFunc("/v2/wallets/applepay/sessions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		var body map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "pay.example.org", body["domain"])
+		assert.Equal(t, "https://apple-pay-gateway-cert.apple.com/paymentservices/paymentSession", body["validationUrl"])
+		assert.Equal(t, "pfl_QkEhN94Ba", body["profileId"])
+
+		_, _ = w.Write([]byte(testdata.ApplePaySessionResponse))
+	})
+
+	_, aps, err := tClient.Wallets.RequestApplePayPaymentSession(context.Background(), &ApplePaymentSessionRequest{
+		Domain:        "pay.example.org",
+		ValidationURL: "https://apple-pay-gateway-cert.apple.com/paymentservices/paymentSession",
+		ProfileID:     "pfl_QkEhN94Ba",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1555507053169), aps.EpochTimestamp)
+	assert.Equal(t, "BD62FEB196874511C22DB28A9E14A89E3534C93194F73EA417EC566368D391EB", aps.MerchantID)
+	assert.JSONEq(t, testdata.ApplePaySessionResponse, string(aps.Raw))
+}
+
+func TestWalletsService_RequestApplePayPaymentSession_APIError(t *testing.T) {
+	setEnv()
+	defer unsetEnv()
+	setup()
+	defer teardown()
+
+	tMux.HandleFunc("/v2/wallets/applepay/sessions", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(testdata.UnprocessableEntityErrorResponse))
+	})
+
+	_, aps, err := tClient.Wallets.RequestApplePayPaymentSession(context.Background(), &ApplePaymentSessionRequest{
+		Domain: "pay.example.org",
+	})
+
+	var apiErr *BaseError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusUnprocessableEntity, apiErr.Status)
+	assert.Nil(t, aps)
+}
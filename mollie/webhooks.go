@@ -0,0 +1,93 @@
+package mollie
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// WebhookSignatureHeader contains the HMAC-SHA256 signature of signed webhook calls.
+const WebhookSignatureHeader = "X-Mollie-Signature"
+
+// MaxWebhookPayloadBytes bounds the size of the webhook bodies read by
+// WebhookHandler, Mollie only sends the id of the resource.
+const MaxWebhookPayloadBytes int64 = 16 << 10
+
+// ErrInvalidWebhookSignature is returned when the signature of a webhook call
+// does not match the signature computed for its payload.
+var ErrInvalidWebhookSignature = errors.New("invalid webhook signature")
+
+// VerifyWebhookSignature computes the HMAC-SHA256 of the raw payload using the
+// provided secret and compares it in constant time against the hex encoded
+// signature header, an optional "sha256=" prefix is accepted.
+func VerifyWebhookSignature(secret string, payload []byte, signatureHeader string) error {
+	sig, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(signatureHeader), "sha256="))
+	if err != nil || len(sig) == 0 {
+		return ErrInvalidWebhookSignature
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+
+	if !hmac.Equal(sig, mac.Sum(nil)) {
+		return ErrInvalidWebhookSignature
+	}
+
+	return nil
+}
+
+// WebhookHandler returns a http.Handler that extracts the resource id from
+// the webhook call and passes it to fn.
+//
+// Bodies larger than MaxWebhookPayloadBytes are answered with 413 Request Entity
+// Too Large before verifying them. When secret is not empty the signature header
+// is verified before dispatching, calls with an invalid signature are answered
+// with 401 Unauthorized.
+// Calls without an id are answered with 400 Bad Request and errors returned
+// by fn with 500 Internal Server Error so Mollie retries the call later.
+func WebhookHandler(secret string, fn func(ctx context.Context, id string) error) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		payload, err := io.ReadAll(http.MaxBytesReader(w, r.Body, MaxWebhookPayloadBytes))
+		if err != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				w.WriteHeader(http.StatusRequestEntityTooLarge)
+				return
+			}
+
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if secret != "" {
+			if err := VerifyWebhookSignature(secret, payload, r.Header.Get(WebhookSignatureHeader)); err != nil {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+		}
+
+		values, err := url.ParseQuery(string(payload))
+		if err != nil || values.Get("id") == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if err := fn(r.Context(), values.Get("id")); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
@@ -0,0 +1,98 @@
+package mollie
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func sign(secret, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(payload))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestVerifyWebhookSignature(t *testing.T) {
+	payload := "id=tr_WDqYK6vllg"
+
+	cases := []struct {
+		name      string
+		signature string
+		err       error
+	}{
+		{"valid signatures are accepted", sign("secret", payload), nil},
+		{"prefixed signatures are accepted", "sha256=" + sign("secret", payload), nil},
+		{"signatures using another secret are rejected", sign("other", payload), ErrInvalidWebhookSignature},
+		{"malformed signatures are rejected", "not-hex", ErrInvalidWebhookSignature},
+		{"empty signatures are rejected", "", ErrInvalidWebhookSignature},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := VerifyWebhookSignature("secret", []byte(payload), c.signature)
+			assert.Equal(t, c.err, err)
+		})
+	}
+}
+
+func TestWebhookHandler(t *testing.T) {
+	payload := "id=tr_WDqYK6vllg"
+
+	cases := []struct {
+		name      string
+		secret    string
+		method    string
+		payload   string
+		signature string
+		fnErr     error
+		status    int
+		id        string
+	}{
+		{"unsigned calls are dispatched", "", http.MethodPost, payload, "", nil, http.StatusOK, "tr_WDqYK6vllg"},
+		{"signed calls are dispatched", "secret", http.MethodPost, payload, sign("secret", payload), nil, http.StatusOK, "tr_WDqYK6vllg"},
+		{"invalid signatures are rejected", "secret", http.MethodPost, payload, sign("other", payload), nil, http.StatusUnauthorized, ""},
+		{"calls without id are rejected", "", http.MethodPost, "foo=bar", "", nil, http.StatusBadRequest, ""},
+		{"only post calls are accepted", "", http.MethodGet, payload, "", nil, http.StatusMethodNotAllowed, ""},
+		{"dispatch errors are reported", "", http.MethodPost, payload, "", errors.New("boom"), http.StatusInternalServerError, "tr_WDqYK6vllg"},
+		{
+			"oversized calls are rejected",
+			"secret",
+			http.MethodPost,
+			payload + "&pad=" + strings.Repeat("x", int(MaxWebhookPayloadBytes)),
+			"",
+			nil,
+			http.StatusRequestEntityTooLarge,
+			"",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var got string
+
+			h := WebhookHandler(c.secret, func(ctx context.Context, id string) error {
+				got = id
+				return c.fnErr
+			})
+
+			r := httptest.NewRequest(c.method, "/webhooks", strings.NewReader(c.payload))
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			r.Header.Set(WebhookSignatureHeader, c.signature)
+			w := httptest.NewRecorder()
+
+			h.ServeHTTP(w, r)
+
+			assert.Equal(t, c.status, w.Code)
+			assert.Equal(t, c.id, got)
+		})
+	}
+}
@@ -0,0 +1,12 @@
+// Package mollietest provides helpers to unit test code built on top of
+// the mollie package without reaching Mollie's API.
+//
+// NewServer starts an httptest.Server answering the registered routes and
+// returns a *mollie.Client pointed at it, the server is closed once the test ends.
+//
+//	client := mollietest.NewServer(t, mollietest.Routes{
+//		"GET /v2/payments/tr_WDqYK6vllg/chargebacks/chb_n9z0tp": mollietest.JSON(http.StatusOK, mollietest.ChargebackFixture),
+//	})
+//
+// Requests without a matching route are answered with a 404 Not Found error.
+package mollietest
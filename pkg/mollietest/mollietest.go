@@ -0,0 +1,90 @@
+package mollietest
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/VictorAvelar/mollie-api-go/v4/mollie"
+	"github.com/VictorAvelar/mollie-api-go/v4/testdata"
+)
+
+// Fixtures for the common resources, they match the examples of Mollie's docs.
+const (
+	PaymentFixture        = testdata.GetPaymentResponse
+	ChargebackFixture     = testdata.GetChargebackResponse
+	ChargebackListFixture = testdata.ListChargebacksResponse
+	RefundFixture         = testdata.GetPaymentRefundResponse
+	NotFoundFixture       = testdata.NotFoundErrorResponse
+)
+
+// APIKey is the authentication value used by the clients returned by NewServer.
+const APIKey = "test_mollietest"
+
+// Routes maps a route to the handler answering it.
+//
+// Routes are written as "METHOD /path", e.g. "GET /v2/chargebacks",
+// when the method is omitted the handler answers every method.
+type Routes map[string]http.HandlerFunc
+
+// NewServer starts a server answering the given routes and returns a client
+// pointed at it, the server is closed when the test and its subtests complete.
+func NewServer(t testing.TB, routes Routes) *mollie.Client {
+	t.Helper()
+
+	srv := httptest.NewServer(routes.handler())
+	t.Cleanup(srv.Close)
+
+	client, err := mollie.NewClient(srv.Client(), mollie.NewAPITestingConfig(false))
+	if err != nil {
+		t.Fatalf("mollietest: creating client: %v", err)
+	}
+
+	if err := client.WithAuthenticationValue(APIKey); err != nil {
+		t.Fatalf("mollietest: setting authentication: %v", err)
+	}
+
+	client.BaseURL, err = url.Parse(srv.URL + "/")
+	if err != nil {
+		t.Fatalf("mollietest: parsing server url: %v", err)
+	}
+
+	return client
+}
+
+// JSON returns a handler answering with the given status and json body.
+func JSON(status int, body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/hal+json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}
+}
+
+// handler dispatches the requests to the matching route.
+func (r Routes) handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if h, ok := r[req.Method+" "+req.URL.Path]; ok {
+			h(w, req)
+
+			return
+		}
+
+		if h, ok := r[req.URL.Path]; ok {
+			h(w, req)
+
+			return
+		}
+
+		JSON(http.StatusNotFound, notFound(req))(w, req)
+	})
+}
+
+// notFound returns a Mollie error body for the requests without route.
+func notFound(req *http.Request) string {
+	detail := fmt.Sprintf("no route registered for %s %s", req.Method, req.URL.Path)
+
+	return fmt.Sprintf(`{"status":404,"title":"Not Found","detail":%q}`, detail)
+}
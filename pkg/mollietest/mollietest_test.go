@@ -0,0 +1,42 @@
+package mollietest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/VictorAvelar/mollie-api-go/v4/mollie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewServer(t *testing.T) {
+	client := NewServer(t, Routes{
+		"GET /v2/payments/tr_WDqYK6vllg/chargebacks/chb_n9z0tp": JSON(http.StatusOK, ChargebackFixture),
+		"/v2/chargebacks": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "Bearer "+APIKey, r.Header.Get(mollie.AuthHeader))
+			JSON(http.StatusOK, ChargebackListFixture)(w, r)
+		},
+	})
+
+	_, cb, err := client.Chargebacks.Get(context.Background(), "tr_WDqYK6vllg", "chb_n9z0tp", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "chb_n9z0tp", cb.ID)
+
+	_, cl, err := client.Chargebacks.List(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, 3, cl.Count)
+}
+
+func TestNewServer_NotFound(t *testing.T) {
+	client := NewServer(t, Routes{
+		"GET /v2/payments/tr_WDqYK6vllg": JSON(http.StatusOK, PaymentFixture),
+	})
+
+	_, _, err := client.Payments.Cancel(context.Background(), "tr_WDqYK6vllg")
+
+	var apiErr *mollie.BaseError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusNotFound, apiErr.Status)
+	assert.Equal(t, "no route registered for DELETE /v2/payments/tr_WDqYK6vllg", apiErr.Detail)
+}
@@ -157,3 +157,17 @@ const CreateCaptureWithAccessTokenResponse = `{
         }
     }
 }`
+
+// CreateCaptureNotAuthorizedResponse example.
+const CreateCaptureNotAuthorizedResponse = `{
+    "status": 422,
+    "title": "Unprocessable Entity",
+    "detail": "The payment is not authorized and can not be captured",
+    "field": "paymentId",
+    "_links": {
+        "documentation": {
+            "href": "https://docs.mollie.com/reference/v2/captures-api/create-capture",
+            "type": "text/html"
+        }
+    }
+}`
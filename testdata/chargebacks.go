@@ -81,5 +81,134 @@ const (
             "type": "text/html"
         }
     }
+}`
+	ListChargebacksFirstPageResponse = `{
+    "count": 1,
+    "_embedded": {
+        "chargebacks": [
+            {
+                "resource": "chargeback",
+                "id": "chb_n9z0tp",
+                "amount": {
+                    "currency": "EUR",
+                    "value": "43.38"
+                },
+                "createdAt": "2018-03-14T17:00:52.0Z",
+                "paymentId": "tr_WDqYK6vllg"
+            }
+        ]
+    },
+    "_links": {
+        "self": {
+            "href": "https://api.mollie.com/v2/chargebacks?limit=1",
+            "type": "application/hal+json"
+        },
+        "previous": null,
+        "next": {
+            "href": "https://api.mollie.com/v2/chargebacks?from=chb_xvb2kq&limit=1",
+            "type": "application/hal+json"
+        },
+        "documentation": {
+            "href": "https://docs.mollie.com/reference/v2/chargebacks-api/list-chargebacks",
+            "type": "text/html"
+        }
+    }
+}`
+	ListChargebacksLastPageResponse = `{
+    "count": 1,
+    "_embedded": {
+        "chargebacks": [
+            {
+                "resource": "chargeback",
+                "id": "chb_xvb2kq",
+                "amount": {
+                    "currency": "EUR",
+                    "value": "12.50"
+                },
+                "createdAt": "2018-03-12T10:20:11.0Z",
+                "paymentId": "tr_7UhSN1zuXS"
+            }
+        ]
+    },
+    "_links": {
+        "self": {
+            "href": "https://api.mollie.com/v2/chargebacks?from=chb_xvb2kq&limit=1",
+            "type": "application/hal+json"
+        },
+        "previous": {
+            "href": "https://api.mollie.com/v2/chargebacks?from=chb_n9z0tp&limit=1",
+            "type": "application/hal+json"
+        },
+        "next": null,
+        "documentation": {
+            "href": "https://docs.mollie.com/reference/v2/chargebacks-api/list-chargebacks",
+            "type": "text/html"
+        }
+    }
+}`
+	ListChargebacksAcrossProfilesResponse = `{
+    "count": 3,
+    "_embedded": {
+        "chargebacks": [
+            {
+                "resource": "chargeback",
+                "id": "chb_n9z0tp",
+                "amount": {
+                    "currency": "EUR",
+                    "value": "43.38"
+                },
+                "createdAt": "2018-03-14T17:00:52.0Z",
+                "paymentId": "tr_WDqYK6vllg"
+            },
+            {
+                "resource": "chargeback",
+                "id": "chb_xvb2kq",
+                "amount": {
+                    "currency": "EUR",
+                    "value": "12.50"
+                },
+                "createdAt": "2018-03-12T10:20:11.0Z",
+                "_links": {
+                    "payment": {
+                        "href": "https://api.mollie.com/v2/payments/tr_7UhSN1zuXS",
+                        "type": "application/hal+json"
+                    }
+                }
+            },
+            {
+                "resource": "chargeback",
+                "id": "chb_ls7ahg",
+                "amount": {
+                    "currency": "EUR",
+                    "value": "5.00"
+                },
+                "createdAt": "2018-03-10T08:00:00.0Z",
+                "paymentId": "tr_WDqYK6vllg"
+            }
+        ]
+    },
+    "_links": {
+        "self": {
+            "href": "https://api.mollie.com/v2/chargebacks",
+            "type": "application/hal+json"
+        },
+        "previous": null,
+        "next": null,
+        "documentation": {
+            "href": "https://docs.mollie.com/reference/v2/chargebacks-api/list-chargebacks",
+            "type": "text/html"
+        }
+    }
+}`
+	GetPaymentOtherProfileResponse = `{
+    "resource": "payment",
+    "id": "tr_7UhSN1zuXS",
+    "mode": "live",
+    "status": "paid",
+    "amount": {
+        "value": "12.50",
+        "currency": "EUR"
+    },
+    "profileId": "pfl_v9hTwCvYqw"
 }`
 )
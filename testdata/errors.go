@@ -13,6 +13,19 @@ const UnauthorizedErrorResponse = `{
     }
 }`
 
+// ForbiddenErrorResponse example.
+const ForbiddenErrorResponse = `{
+    "status": 403,
+    "title": "Forbidden",
+    "detail": "The access token is missing the required permission: payments.read",
+    "_links": {
+        "documentation": {
+            "href": "https://docs.mollie.com/connect/permissions",
+            "type": "text/html"
+        }
+    }
+}`
+
 // NotFoundErrorResponse example.
 const NotFoundErrorResponse = `{
     "status": 404,
@@ -40,6 +53,31 @@ const UnprocessableEntityErrorResponse = `{
     }
 }`
 
+// UnprocessableEntityMultipleErrorsResponse example.
+const UnprocessableEntityMultipleErrorsResponse = `{
+    "status": 422,
+    "title": "Unprocessable Entity",
+    "detail": "The request contains multiple invalid fields",
+    "_embedded": {
+        "errors": [
+            {
+                "field": "amount.value",
+                "detail": "The amount is higher than the maximum"
+            },
+            {
+                "field": "billingAddress.email",
+                "detail": "The email address is invalid"
+            }
+        ]
+    },
+    "_links": {
+       "documentation": {
+            "href": "https://docs.mollie.com/errors",
+            "type": "text/html"
+        }
+    }
+}`
+
 // InternalServerErrorResponse example.
 const InternalServerErrorResponse = `{
     "status": 500,
@@ -52,3 +90,55 @@ const InternalServerErrorResponse = `{
         }
     }
 }`
+
+// ServiceUnavailableErrorResponse example.
+const ServiceUnavailableErrorResponse = `{
+    "status": 503,
+    "title": "Service Unavailable",
+    "detail": "The API is temporarily unavailable due to maintenance, please try again later.",
+    "_links": {
+        "documentation": {
+            "href": "https://docs.mollie.com/errors",
+            "type": "text/html"
+        }
+    }
+}`
+
+// StatusPageServiceUnavailableErrorResponse example.
+const StatusPageServiceUnavailableErrorResponse = `{
+    "status": 503,
+    "title": "Service Unavailable",
+    "detail": "The service is temporarily unavailable, please try again later.",
+    "_links": {
+        "documentation": {
+            "href": "https://status.mollie.com/maintenance",
+            "type": "text/html"
+        }
+    }
+}`
+
+// NotImplementedErrorResponse example.
+const NotImplementedErrorResponse = `{
+    "status": 501,
+    "title": "Not Implemented",
+    "detail": "The requested operation is not implemented.",
+    "_links": {
+        "documentation": {
+            "href": "https://docs.mollie.com/errors",
+            "type": "text/html"
+        }
+    }
+}`
+
+// TransientServiceUnavailableErrorResponse example.
+const TransientServiceUnavailableErrorResponse = `{
+    "status": 503,
+    "title": "Service Unavailable",
+    "detail": "The service is temporarily unavailable, please try again later.",
+    "_links": {
+        "documentation": {
+            "href": "https://docs.mollie.com/errors",
+            "type": "text/html"
+        }
+    }
+}`
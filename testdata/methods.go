@@ -166,3 +166,82 @@ const GetMethodResponse = `{
          }
      }
  }`
+
+// GetMethodWithoutIncludesResponse example
+const GetMethodWithoutIncludesResponse = `{
+     "resource": "method",
+     "id": "ideal",
+     "description": "iDEAL",
+     "minimumAmount": {
+         "value": "0.01",
+         "currency": "EUR"
+     },
+     "maximumAmount": {
+         "value": "50000.00",
+         "currency": "EUR"
+     },
+     "image": {
+         "size1x": "https://www.mollie.com/external/icons/payment-methods/ideal.png",
+         "size2x": "https://www.mollie.com/external/icons/payment-methods/ideal%402x.png",
+         "svg": "https://www.mollie.com/external/icons/payment-methods/ideal.svg"
+     },
+     "_links": {
+         "self": {
+             "href": "https://api.mollie.com/v2/methods/ideal",
+             "type": "application/hal+json"
+         },
+         "documentation": {
+             "href": "https://docs.mollie.com/reference/v2/methods-api/get-method",
+             "type": "text/html"
+         }
+     }
+ }`
+
+// GetGiftCardMethodWithIssuersResponse example
+const GetGiftCardMethodWithIssuersResponse = `{
+     "resource": "method",
+     "id": "giftcard",
+     "description": "Gift cards",
+     "minimumAmount": {
+         "value": "0.01",
+         "currency": "EUR"
+     },
+     "maximumAmount": null,
+     "image": {
+         "size1x": "https://www.mollie.com/external/icons/payment-methods/giftcard.png",
+         "size2x": "https://www.mollie.com/external/icons/payment-methods/giftcard%402x.png",
+         "svg": "https://www.mollie.com/external/icons/payment-methods/giftcard.svg"
+     },
+     "issuers": [
+         {
+             "resource": "issuer",
+             "id": "boekenbon",
+             "name": "Boekenbon",
+             "image": {
+                 "size1x": "https://www.mollie.com/external/icons/giftcards/boekenbon.png",
+                 "size2x": "https://www.mollie.com/external/icons/giftcards/boekenbon%402x.png",
+                 "svg": "https://www.mollie.com/external/icons/giftcards/boekenbon.svg"
+             }
+         },
+         {
+             "resource": "issuer",
+             "id": "fashioncheque",
+             "name": "Fashioncheque",
+             "image": {
+                 "size1x": "https://www.mollie.com/external/icons/giftcards/fashioncheque.png",
+                 "size2x": "https://www.mollie.com/external/icons/giftcards/fashioncheque%402x.png",
+                 "svg": "https://www.mollie.com/external/icons/giftcards/fashioncheque.svg"
+             }
+         }
+     ],
+     "_links": {
+         "self": {
+             "href": "https://api.mollie.com/v2/methods/giftcard",
+             "type": "application/hal+json"
+         },
+         "documentation": {
+             "href": "https://docs.mollie.com/reference/v2/methods-api/get-method",
+             "type": "text/html"
+         }
+     }
+ }`
@@ -83,3 +83,51 @@ const GetPartnerClientResponse = `{
         }
     }
 }`
+
+const GetPartnerClientEmbeddedResponse = `{
+    "resource": "client",
+    "id": "org_1337",
+    "organizationCreatedAt": "2018-03-21T13:13:37+00:00",
+    "commission": {
+        "count": 200,
+        "totalAmount": {
+            "currency": "EUR",
+            "value": "10.00"
+        }
+    },
+    "_embedded": {
+        "organization": {
+            "resource": "organization",
+            "id": "org_1337",
+            "name": "Mollie B.V.",
+            "email": "info@mollie.com",
+            "locale": "nl_NL"
+        },
+        "onboarding": {
+            "resource": "onboarding",
+            "name": "Mollie B.V.",
+            "signedUpAt": "2018-12-20T10:49:08+00:00",
+            "status": "completed",
+            "canReceivePayments": true,
+            "canReceiveSettlements": true
+        }
+    },
+    "_links": {
+        "self": {
+            "href": "https://api.mollie.com/v2/clients/org_1337",
+            "type": "application/hal+json"
+        },
+        "organization": {
+            "href": "https://api.mollie.com/v2/organizations/org_1337",
+            "type": "application/hal+json"
+        },
+        "onboarding": {
+            "href": "https://api.mollie.com/v2/onboarding/org_1337",
+            "type": "application/hal+json"
+        },
+        "documentation": {
+            "href": "https://docs.mollie.com/reference/v2/partners-api/get-client",
+            "type": "text/html"
+        }
+    }
+}`
@@ -45,6 +45,92 @@ const GetPaymentResponse = `{
     }
 }`
 
+// GetPaymentWithQRCodeResponse example
+const GetPaymentWithQRCodeResponse = `{
+    "resource": "payment",
+    "id": "tr_WDqYK6vllg",
+    "mode": "test",
+    "createdAt": "2018-03-20T13:13:37+00:00",
+    "amount": {
+        "value": "10.00",
+        "currency": "EUR"
+    },
+    "description": "Order #12345",
+    "method": "ideal",
+    "status": "open",
+    "details": {
+        "qrCode": {
+            "height": 180,
+            "width": 180,
+            "src": "https://www.mollie.com/qr/12345678.png"
+        }
+    },
+    "_links": {
+        "self": {
+            "href": "https://api.mollie.com/v2/payments/tr_WDqYK6vllg",
+            "type": "application/hal+json"
+        }
+    }
+}`
+
+// GetPaymentWithEmbeddedResourcesResponse example
+const GetPaymentWithEmbeddedResourcesResponse = `{
+    "resource": "payment",
+    "id": "tr_WDqYK6vllg",
+    "mode": "test",
+    "createdAt": "2018-03-20T13:13:37+00:00",
+    "amount": {
+        "value": "10.00",
+        "currency": "EUR"
+    },
+    "description": "Order #12345",
+    "status": "paid",
+    "profileId": "pfl_QkEhN94Ba",
+    "_embedded": {
+        "refunds": [
+            {
+                "resource": "refund",
+                "id": "re_4qqhO89gsT",
+                "amount": {
+                    "currency": "EUR",
+                    "value": "5.95"
+                },
+                "status": "pending",
+                "paymentId": "tr_WDqYK6vllg"
+            }
+        ],
+        "chargebacks": [
+            {
+                "resource": "chargeback",
+                "id": "chb_n9z0tp",
+                "amount": {
+                    "currency": "USD",
+                    "value": "43.38"
+                },
+                "paymentId": "tr_WDqYK6vllg"
+            }
+        ],
+        "captures": [
+            {
+                "resource": "capture",
+                "id": "cpt_4qqhO89gsT",
+                "amount": {
+                    "currency": "EUR",
+                    "value": "4.05"
+                },
+                "status": "succeeded",
+                "paymentId": "tr_WDqYK6vllg"
+            }
+        ]
+    },
+    "_links": {
+        "self": {
+            "href": "https://api.mollie.com/v2/payments/tr_WDqYK6vllg",
+            "type": "application/hal+json"
+        }
+    }
+}`
+
 // CancelPaymentResponse example
 const CancelPaymentResponse = `{
     "resource": "payment",
@@ -173,3 +259,33 @@ const ListPaymentsResponse = `{
         }
     }
 }`
+
+// ListPaymentRoutesResponse example
+const ListPaymentRoutesResponse = `{
+    "count": 1,
+    "_embedded": {
+        "routes": [
+            {
+                "resource": "route",
+                "id": "rt_9dk4al1n",
+                "paymentId": "tr_WDqYK6vllg",
+                "amount": {
+                    "value": "7.50",
+                    "currency": "EUR"
+                },
+                "destination": {
+                    "type": "organization",
+                    "organizationId": "org_23456"
+                },
+                "releaseDate": "2024-01-01",
+                "createdAt": "2023-12-01T10:00:00+00:00"
+            }
+        ]
+    },
+    "_links": {
+        "self": {
+            "href": "https://api.mollie.com/v2/payments/tr_WDqYK6vllg/routes",
+            "type": "application/hal+json"
+        }
+    }
+}`
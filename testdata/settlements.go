@@ -420,3 +420,106 @@ const ListSettlementsResponse = `
         }
     }
 }`
+
+// ListSettlementPaymentsFirstPageResponse example
+const ListSettlementPaymentsFirstPageResponse = `{
+    "count": 2,
+    "_embedded": {
+        "payments": [
+            {
+                "resource": "payment",
+                "id": "tr_7UhSN1zuXS",
+                "amount": {"value": "10.00", "currency": "EUR"},
+                "settlementAmount": {"value": "10.00", "currency": "EUR"}
+            },
+            {
+                "resource": "payment",
+                "id": "tr_WDqYK6vllg",
+                "amount": {"value": "25.50", "currency": "EUR"},
+                "settlementAmount": {"value": "25.50", "currency": "EUR"}
+            }
+        ]
+    },
+    "_links": {
+        "self": {
+            "href": "https://api.mollie.com/v2/settlements/stl_jDk30akdN/payments?limit=250",
+            "type": "application/hal+json"
+        },
+        "previous": null,
+        "next": {
+            "href": "https://api.mollie.com/v2/settlements/stl_jDk30akdN/payments?from=tr_Hd3a5fYp2c&limit=250",
+            "type": "application/hal+json"
+        }
+    }
+}`
+
+// ListSettlementPaymentsLastPageResponse example
+const ListSettlementPaymentsLastPageResponse = `{
+    "count": 1,
+    "_embedded": {
+        "payments": [
+            {
+                "resource": "payment",
+                "id": "tr_Hd3a5fYp2c",
+                "amount": {"value": "4.50", "currency": "EUR"},
+                "settlementAmount": {"value": "4.50", "currency": "EUR"}
+            }
+        ]
+    },
+    "_links": {
+        "self": {
+            "href": "https://api.mollie.com/v2/settlements/stl_jDk30akdN/payments?from=tr_Hd3a5fYp2c&limit=250",
+            "type": "application/hal+json"
+        },
+        "previous": null,
+        "next": null
+    }
+}`
+
+// ListSettlementRefundsResponse example
+const ListSettlementRefundsResponse = `{
+    "count": 1,
+    "_embedded": {
+        "refunds": [
+            {
+                "resource": "refund",
+                "id": "re_4qqhO89gsT",
+                "amount": {"value": "5.00", "currency": "EUR"},
+                "settlementAmount": {"value": "-5.00", "currency": "EUR"},
+                "paymentId": "tr_WDqYK6vllg"
+            }
+        ]
+    },
+    "_links": {
+        "self": {
+            "href": "https://api.mollie.com/v2/settlements/stl_jDk30akdN/refunds?limit=250",
+            "type": "application/hal+json"
+        },
+        "previous": null,
+        "next": null
+    }
+}`
+
+// ListSettlementChargebacksResponse example
+const ListSettlementChargebacksResponse = `{
+    "count": 1,
+    "_embedded": {
+        "chargebacks": [
+            {
+                "resource": "chargeback",
+                "id": "chb_n9z0tp",
+                "amount": {"value": "10.00", "currency": "EUR"},
+                "settlementAmount": {"value": "-10.00", "currency": "EUR"},
+                "paymentId": "tr_7UhSN1zuXS"
+            }
+        ]
+    },
+    "_links": {
+        "self": {
+            "href": "https://api.mollie.com/v2/settlements/stl_jDk30akdN/chargebacks?limit=250",
+            "type": "application/hal+json"
+        },
+        "previous": null,
+        "next": null
+    }
+}`
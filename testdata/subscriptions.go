@@ -19,6 +19,7 @@ const GetSubscriptionResponse = `{
     "description": "Quarterly payment",
     "method": null,
     "mandateId": "mdt_38HS4fsS",
+    "customerId": "cst_stTC2WHAuS",
     "webhookUrl": "https://webshop.example.org/payments/webhook",
     "metadata": {
         "plan": "small"
@@ -32,6 +33,10 @@ const GetSubscriptionResponse = `{
             "href": "https://api.mollie.com/v2/customers/cst_stTC2WHAuS",
             "type": "application/hal+json"
         },
+        "mandate": {
+            "href": "https://api.mollie.com/v2/customers/cst_stTC2WHAuS/mandates/mdt_38HS4fsS",
+            "type": "application/hal+json"
+        },
         "profile": {
             "href": "https://api.mollie.com/v2/profiles/pfl_URR55HPMGx",
             "type": "application/hal+json"